@@ -0,0 +1,162 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//ArtifactType describes the API entity of designtime artifact
+type ArtifactType struct {
+	//Name is used in the command line flag --type
+	Name string
+	//EntitySet is the name of the collection in the API, e.g. IntegrationDesigntimeArtifacts
+	EntitySet string
+	//DeployFunction is the name of the function import used to deploy artifact
+	DeployFunction string
+	//Description is used in the output messages
+	Description string
+}
+
+var (
+	//FlowArtifact - integration flow
+	FlowArtifact = ArtifactType{
+		Name:           "flow",
+		EntitySet:      "IntegrationDesigntimeArtifacts",
+		DeployFunction: "DeployIntegrationDesigntimeArtifact",
+		Description:    "Integration flow",
+	}
+	//ValueMappingArtifact - value mapping
+	ValueMappingArtifact = ArtifactType{
+		Name:           "valuemapping",
+		EntitySet:      "ValueMappingDesigntimeArtifacts",
+		DeployFunction: "DeployValueMappingDesigntimeArtifact",
+		Description:    "Value mapping",
+	}
+	//MessageMappingArtifact - message mapping
+	MessageMappingArtifact = ArtifactType{
+		Name:           "messagemapping",
+		EntitySet:      "MessageMappingDesigntimeArtifacts",
+		DeployFunction: "DeployMessageMappingDesigntimeArtifact",
+		Description:    "Message mapping",
+	}
+	//ScriptCollectionArtifact - script collection
+	ScriptCollectionArtifact = ArtifactType{
+		Name:           "scriptcollection",
+		EntitySet:      "ScriptCollectionDesigntimeArtifacts",
+		DeployFunction: "DeployScriptCollectionDesigntimeArtifact",
+		Description:    "Script collection",
+	}
+
+	//ArtifactTypes - all supported artifact types
+	ArtifactTypes = []ArtifactType{FlowArtifact, ValueMappingArtifact, MessageMappingArtifact, ScriptCollectionArtifact}
+)
+
+//GetArtifactType returns artifact type by name used in the command line
+func GetArtifactType(name string) (ArtifactType, error) {
+	var names []string
+	for _, t := range ArtifactTypes {
+		if t.Name == name {
+			return t, nil
+		}
+		names = append(names, t.Name)
+	}
+	return ArtifactType{}, fmt.Errorf("%w: unknown artifact type %q, available values: %s", ErrInvalid, name, strings.Join(names, ", "))
+}
+
+func (t ArtifactType) artifactURL(conf config.Configuration, id string, version string) string {
+	return conf.ApiURL + "/" + t.EntitySet + "(Id='" + id + "',Version='" + version + "')"
+}
+
+//RunGetArtifactsOfIntegrationPackage - call the function GetArtifactsOfIntegrationPackage
+func RunGetArtifactsOfIntegrationPackage(out io.Writer, conf config.Configuration, artifactType ArtifactType, packageID string) {
+	resp, err := GetArtifactsOfIntegrationPackage(conf, artifactType, packageID)
+	if err != nil {
+		log.Fatal("Error in GetArtifactsOfIntegrationPackage:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetArtifactsOfIntegrationPackage is the function to get list of artifacts of given type of the integration package
+func GetArtifactsOfIntegrationPackage(conf config.Configuration, artifactType ArtifactType, packageID string) (*model.ArtifactsOfIPResponse, error) {
+	var decodedRes model.ArtifactsOfIPResponse
+	err := getArtifactsOfIntegrationPackage(conf, artifactType, packageID, &decodedRes)
+	if err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+func getArtifactsOfIntegrationPackage(conf config.Configuration, artifactType ArtifactType, packageID string, decodedRes interface{}) error {
+	artifactsURL := conf.ApiURL + "/IntegrationPackages('" + packageID + "')/" + artifactType.EntitySet
+	log.Println("GET ", artifactsURL)
+
+	request, err := http.NewRequest("GET", artifactsURL, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return err
+	}
+
+	return json.NewDecoder(response.Body).Decode(decodedRes)
+}
+
+//RunInspectArtifact - call the function InspectArtifact
+func RunInspectArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, id string, version string) {
+	resp, err := InspectArtifact(conf, artifactType, id, version)
+	if err != nil {
+		log.Fatal("Error in InspectArtifact:\n", err)
+	}
+	resp.Print(out)
+}
+
+//InspectArtifact - get designtime artifact by id and version
+func InspectArtifact(conf config.Configuration, artifactType ArtifactType, id string, version string) (*model.ArtifactByIdResponse, error) {
+	var decodedRes model.ArtifactByIdResponse
+	err := inspectArtifact(conf, artifactType, id, version, &decodedRes)
+	if err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+func inspectArtifact(conf config.Configuration, artifactType ArtifactType, id string, version string, decodedRes interface{}) error {
+	artifactURL := artifactType.artifactURL(conf, id, version)
+	log.Println("GET ", artifactURL)
+	request, err := http.NewRequest("GET", artifactURL, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return err
+	}
+
+	return json.NewDecoder(response.Body).Decode(decodedRes)
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"io"
+	"log"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunCopyArtifact - call the function CopyArtifact
+func RunCopyArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, srcID string, destID string, destName string, destPackageID string) {
+	err := CopyArtifact(out, conf, artifactType, srcID, destID, destName, destPackageID)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+//CopyArtifact is the function to copy designtime artifacts in the same system
+func CopyArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, srcID string, destID string, destName string, destPackageID string) error {
+	version := "active"
+	srcArtifact, err := InspectArtifact(conf, artifactType, srcID, version)
+	if err != nil {
+		return err
+	}
+
+	tmpFileName, err := getTmpFileName()
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFileName)
+
+	outputContent, err := os.OpenFile(tmpFileName, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+	defer outputContent.Close()
+
+	err = DownloadArtifact(out, conf, artifactType, srcID, version, outputContent)
+	if err != nil {
+		return err
+	}
+
+	if destName == "" {
+		destName = srcArtifact.D.Name
+	}
+
+	if destPackageID == "" {
+		destPackageID = srcArtifact.D.PackageID
+	}
+
+	if srcID != destID || destName != srcArtifact.D.Name {
+		tmpFileName, err = adjustDownloadedFlow(tmpFileName, destID, destName)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(tmpFileName)
+	}
+
+	tmpFileContent, err := os.Open(tmpFileName)
+	if err != nil {
+		return err
+	}
+	defer tmpFileContent.Close()
+
+	createResp, err := CreateArtifact(conf, artifactType, destName, destID, destPackageID, tmpFileContent)
+	if err != nil {
+		return err
+	}
+
+	createResp.Print(out)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//RunCreateArtifact - call the function CreateArtifact
+func RunCreateArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, name string, id string, packageid string, fileName string) {
+	var fileContent io.ReadCloser
+	var err error
+	if fileName != "" {
+		fileContent, err = os.Open(fileName)
+		if err != nil {
+			log.Fatal("Error Openning file:\n", err)
+		}
+		defer fileContent.Close()
+	}
+
+	resp, err := CreateArtifact(conf, artifactType, name, id, packageid, fileContent)
+	if err != nil {
+		log.Fatal("Error in CreateArtifact:\n", err)
+	}
+	resp.Print(out)
+}
+
+//CreateArtifact - create designtime artifact, it is possible to create empty artifact or with content
+func CreateArtifact(conf config.Configuration, artifactType ArtifactType, name string, id string, packageid string, content io.Reader) (*model.ArtifactByIdResponse, error) {
+	var decodedRes model.ArtifactByIdResponse
+	err := createArtifact(conf, artifactType, name, id, packageid, content, &decodedRes)
+	if err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+func createArtifact(conf config.Configuration, artifactType ArtifactType, name string, id string, packageid string, content io.Reader, decodedRes interface{}) error {
+	csrfToken, cookies, err := getCsrfTokenAndCookies(conf)
+	if err != nil {
+		return err
+	}
+
+	requestBody := map[string]string{
+		"Name":      name,
+		"Id":        id,
+		"PackageId": packageid,
+	}
+
+	if content != nil {
+		contentData, err := io.ReadAll(content)
+		if err != nil {
+			return fmt.Errorf("cannot read artifact content: %w", err)
+		}
+		if len(contentData) > 0 {
+			requestBody["ArtifactContent"] = base64.StdEncoding.EncodeToString(contentData)
+		}
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	createURL := conf.ApiURL + "/" + artifactType.EntitySet
+	log.Println("POST ", createURL)
+
+	request, err := http.NewRequest("POST", createURL, bytes.NewBuffer(requestBodyJSON))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-CSRF-Token", csrfToken)
+	for i := range cookies {
+		request.AddCookie(cookies[i])
+	}
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return err
+	}
+
+	return json.NewDecoder(response.Body).Decode(decodedRes)
+}
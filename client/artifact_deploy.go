@@ -0,0 +1,60 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunDeployArtifact - call the function DeployArtifact
+func RunDeployArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, id string, version string) {
+	err := DeployArtifact(out, conf, artifactType, id, version)
+	if err != nil {
+		log.Fatal("Error in DeployArtifact:\n", err)
+	}
+}
+
+//DeployArtifact - deploy designtime artifact
+func DeployArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, id string, version string) error {
+	csrfToken, cookies, err := getCsrfTokenAndCookies(conf)
+	if err != nil {
+		return err
+	}
+
+	deployURL := conf.ApiURL + "/" + artifactType.DeployFunction + "?Id='" + id + "'&Version='" + version + "'"
+	log.Println("POST ", deployURL)
+
+	request, err := http.NewRequest("POST", deployURL, nil)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-CSRF-Token", csrfToken)
+	for i := range cookies {
+		request.AddCookie(cookies[i])
+	}
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return err
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("cannot read body: %w", err)
+	}
+	bodyStr := "Task ID:\n" + string(body) + "\n"
+	fmt.Fprintf(out, "%s", bodyStr)
+	return nil
+}
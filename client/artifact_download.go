@@ -0,0 +1,62 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunDownloadArtifact - call the function DownloadArtifact
+func RunDownloadArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, id string, version string, outputFile string) {
+	if outputFile == "" {
+		outputFile = id + ".zip"
+	}
+
+	outputContent, err := os.OpenFile(outputFile, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		log.Fatal("Error Openning file:\n", err)
+	}
+	defer outputContent.Close()
+
+	err = DownloadArtifact(out, conf, artifactType, id, version, outputContent)
+	if err != nil {
+		log.Fatal("Error in DownloadArtifact: ", err)
+	}
+}
+
+//DownloadArtifact is the function to download content of designtime artifact
+func DownloadArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, id string, version string, outputContent io.Writer) error {
+	artifactURL := artifactType.artifactURL(conf, id, version) + "/$value"
+	log.Println("GET ", artifactURL)
+	request, err := http.NewRequest("GET", artifactURL, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return err
+	}
+
+	n, err := saveBodyContent(outputContent, response.Body)
+	if err != nil {
+		return err
+	}
+
+	output := "Content downloaded.\n"
+	output += fmt.Sprintf("number of bytes: %d\n", n)
+	fmt.Fprintf(out, "%s", output)
+	return nil
+}
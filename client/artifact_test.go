@@ -0,0 +1,185 @@
+package client_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/iflow"
+)
+
+func TestGetArtifactType(t *testing.T) {
+	testCases := []struct {
+		name         string
+		expEntitySet string
+		expError     error
+	}{
+		{name: "flow", expEntitySet: "IntegrationDesigntimeArtifacts"},
+		{name: "valuemapping", expEntitySet: "ValueMappingDesigntimeArtifacts"},
+		{name: "messagemapping", expEntitySet: "MessageMappingDesigntimeArtifacts"},
+		{name: "scriptcollection", expEntitySet: "ScriptCollectionDesigntimeArtifacts"},
+		{name: "unknown", expError: client.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			artifactType, err := client.GetArtifactType(tc.name)
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if artifactType.EntitySet != tc.expEntitySet {
+				t.Errorf("Expected entity set %s, got %s", tc.expEntitySet, artifactType.EntitySet)
+			}
+		})
+	}
+}
+
+func TestArtifactRequestURLs(t *testing.T) {
+	testCases := []struct {
+		artifactType client.ArtifactType
+		expLsPath    string
+		expDeploy    string
+	}{
+		{
+			artifactType: client.MessageMappingArtifact,
+			expLsPath:    "/IntegrationPackages('POscenerio')/MessageMappingDesigntimeArtifacts",
+			expDeploy:    "/DeployMessageMappingDesigntimeArtifact",
+		},
+		{
+			artifactType: client.ScriptCollectionArtifact,
+			expLsPath:    "/IntegrationPackages('POscenerio')/ScriptCollectionDesigntimeArtifacts",
+			expDeploy:    "/DeployScriptCollectionDesigntimeArtifact",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.artifactType.Name, func(t *testing.T) {
+			var paths []string
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					paths = append(paths, r.URL.Path)
+					w.WriteHeader(http.StatusOK)
+					if strings.HasPrefix(r.URL.Path, "/Deploy") {
+						fmt.Fprint(w, "327626af-8e45-4c56-4791-4a4858573396")
+						return
+					}
+					fmt.Fprintln(w, `{"d": {"results": [{"Id": "Artifact1", "Version": "1.0.0", "PackageId": "POscenerio", "Name": "Artifact 1"}]}}`)
+				})
+			defer cleanup()
+
+			conf := getTestConfiguration()
+			conf.ApiURL = url
+
+			resp, err := client.GetArtifactsOfIntegrationPackage(conf, tc.artifactType, "POscenerio")
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if len(resp.D.Results) != 1 || resp.D.Results[0].ID != "Artifact1" {
+				t.Errorf("Expected one artifact Artifact1, got %v", resp.D.Results)
+			}
+
+			var out bytes.Buffer
+			err = client.DeployArtifact(&out, conf, tc.artifactType, "Artifact1", "active")
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if !strings.Contains(out.String(), "327626af-8e45-4c56-4791-4a4858573396") {
+				t.Errorf("response body should contain task id")
+			}
+
+			joined := strings.Join(paths, "\n")
+			if !strings.Contains(joined, tc.expLsPath) {
+				t.Errorf("Expected request to %s, got:\n%s", tc.expLsPath, joined)
+			}
+			if !strings.Contains(joined, tc.expDeploy) {
+				t.Errorf("Expected request to %s, got:\n%s", tc.expDeploy, joined)
+			}
+		})
+	}
+}
+
+func TestCopyArtifactManifest(t *testing.T) {
+	srcBody := `{"d": {"Id": "VM_Countries", "Version": "1.0.0", "PackageId": "POscenerio", "Name": "VM Countries"}}`
+	createBody := `{"d": {"Id": "VM_CountriesCopy", "Version": "1.0.0", "PackageId": "POscenerio", "Name": "VM Countries Copy"}}`
+	content := newTestArtifactZip(t, "VM_Countries", map[string]string{"value_mapping.xml": "<vm/>"})
+
+	testCases := []struct {
+		name string
+		copy func(out io.Writer, conf config.Configuration) error
+	}{
+		{
+			name: "copy",
+			copy: func(out io.Writer, conf config.Configuration) error {
+				return client.CopyArtifact(out, conf, client.ValueMappingArtifact, "VM_Countries", "VM_CountriesCopy", "VM Countries Copy", "")
+			},
+		},
+		{
+			name: "transport",
+			copy: func(out io.Writer, conf config.Configuration) error {
+				return client.TransportArtifact(out, conf, client.ValueMappingArtifact, "VM_Countries", conf, "VM_CountriesCopy", "VM Countries Copy", "")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var uploaded []byte
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					switch {
+					case r.Method == "POST":
+						var body map[string]string
+						json.NewDecoder(r.Body).Decode(&body)
+						uploaded, _ = base64.StdEncoding.DecodeString(body["ArtifactContent"])
+						w.WriteHeader(http.StatusCreated)
+						fmt.Fprintln(w, createBody)
+					case !strings.Contains(r.URL.Path, "VM_Countries'"):
+						w.WriteHeader(http.StatusNotFound)
+					case strings.HasSuffix(r.URL.Path, "$value"):
+						w.WriteHeader(http.StatusOK)
+						w.Write(content)
+					default:
+						w.WriteHeader(http.StatusOK)
+						fmt.Fprintln(w, srcBody)
+					}
+				})
+			defer cleanup()
+
+			conf := getTestConfiguration()
+			conf.ApiURL = url
+
+			var out bytes.Buffer
+			if err := tc.copy(&out, conf); err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			flow, err := iflow.Read(uploaded)
+			if err != nil {
+				t.Fatalf("Uploaded content is not a valid archive: %q", err)
+			}
+			if flow.ID() != "VM_CountriesCopy" || flow.Name() != "VM Countries Copy" {
+				t.Errorf("Expected id VM_CountriesCopy and name VM Countries Copy, got %q and %q", flow.ID(), flow.Name())
+			}
+			if project := string(flow.File(".project").Content); !strings.Contains(project, "<name>VM_CountriesCopy</name>") {
+				t.Errorf("Expected .project with the new id, got %q", project)
+			}
+			if f := flow.File("value_mapping.xml"); f == nil || string(f.Content) != "<vm/>" {
+				t.Errorf("Expected value_mapping.xml to be kept, got %v", f)
+			}
+		})
+	}
+}
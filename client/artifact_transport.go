@@ -0,0 +1,92 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunTransportArtifact - call the function TransportArtifact
+func RunTransportArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, srcID string, destID string, destTenantKey string, destName string, destPackageID string) {
+
+	destConf, err := config.NewConfiguration(destTenantKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = TransportArtifact(out, conf, artifactType, srcID, destConf, destID, destName, destPackageID)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+//TransportArtifact is the function for transporting designtime artifact from one system to another.
+//The artifact is updated when it exists in the destination system, otherwise it is created.
+func TransportArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, srcID string, destConf config.Configuration, destID string, destName string, destPackageID string) error {
+	version := "active"
+	srcArtifact, err := InspectArtifact(conf, artifactType, srcID, version)
+	if err != nil {
+		return err
+	}
+
+	tmpFileName, err := getTmpFileName()
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFileName)
+
+	outputContent, err := os.OpenFile(tmpFileName, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+	defer outputContent.Close()
+
+	err = DownloadArtifact(out, conf, artifactType, srcID, version, outputContent)
+	if err != nil {
+		return err
+	}
+
+	if destPackageID == "" {
+		destPackageID = srcArtifact.D.PackageID
+	}
+
+	destArtifact, _ := InspectArtifact(destConf, artifactType, destID, version)
+	destExists := destArtifact != nil && destArtifact.D.ID != ""
+
+	if destName == "" {
+		destName = srcArtifact.D.Name
+		if destExists {
+			destName = destArtifact.D.Name
+		}
+	}
+
+	if srcID != destID || destName != srcArtifact.D.Name {
+		tmpFileName, err = adjustDownloadedFlow(tmpFileName, destID, destName)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(tmpFileName)
+	}
+
+	tmpFileContent, err := os.Open(tmpFileName)
+	if err != nil {
+		return err
+	}
+	defer tmpFileContent.Close()
+
+	if destExists {
+		return UpdateArtifact(out, destConf, artifactType, destName, destID, version, tmpFileContent)
+	}
+
+	createResp, err := CreateArtifact(destConf, artifactType, destName, destID, destPackageID, tmpFileContent)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s created.\n", artifactType.Description)
+	createResp.Print(out)
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunUpdateArtifact - call the function UpdateArtifact
+func RunUpdateArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, name string, id string, version string, fileName string) {
+	var fileContent io.ReadCloser
+	var err error
+	if fileName != "" {
+		fileContent, err = os.Open(fileName)
+		if err != nil {
+			log.Fatal("Error Openning file:\n", err)
+		}
+		defer fileContent.Close()
+	}
+
+	err = UpdateArtifact(out, conf, artifactType, name, id, version, fileContent)
+	if err != nil {
+		log.Fatal("Error in UpdateArtifact:\n", err)
+	}
+}
+
+//UpdateArtifact - update name and content of designtime artifact
+func UpdateArtifact(out io.Writer, conf config.Configuration, artifactType ArtifactType, name string, id string, version string, content io.Reader) error {
+	csrfToken, cookies, err := getCsrfTokenAndCookies(conf)
+	if err != nil {
+		return err
+	}
+
+	requestBody := map[string]string{
+		"Name": name,
+	}
+
+	if content != nil {
+		contentData, err := io.ReadAll(content)
+		if err != nil {
+			return fmt.Errorf("cannot read artifact content: %w", err)
+		}
+		if len(contentData) > 0 {
+			requestBody["ArtifactContent"] = base64.StdEncoding.EncodeToString(contentData)
+		}
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	updateURL := artifactType.artifactURL(conf, id, version)
+	log.Println("PUT ", updateURL)
+
+	request, err := http.NewRequest("PUT", updateURL, bytes.NewBuffer(requestBodyJSON))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-CSRF-Token", csrfToken)
+	for i := range cookies {
+		request.AddCookie(cookies[i])
+	}
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "%s: %s updated\n", artifactType.Description, id)
+	return nil
+}
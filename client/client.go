@@ -1,14 +1,12 @@
 package client
 
 import (
-	"archive/zip"
 	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"io/fs"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -18,6 +16,7 @@ import (
 
 	"github.com/beeekind/go-authhttp"
 	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/iflow"
 	"github.com/tobiaszgithub/cig/model"
 	"golang.org/x/oauth2/clientcredentials"
 )
@@ -33,6 +32,10 @@ var (
 	ErrInvalid = errors.New("invalid data")
 	//ErrNotNumber - not a number
 	ErrNotNumber = errors.New("not a number")
+	//ErrThreshold - checked values exceeded the threshold
+	ErrThreshold = errors.New("threshold exceeded")
+	//ErrTestFailed - tests failed
+	ErrTestFailed = errors.New("tests failed")
 )
 
 func getClient(conf config.Configuration) *http.Client {
@@ -136,39 +139,13 @@ func InspectIntegrationPackage(conf config.Configuration, packageID string) (*mo
 
 //GetFlowsOfIntegrationPackage is the function to get list of integration flow of the integration package
 func GetFlowsOfIntegrationPackage(conf config.Configuration, packageName string) (*model.FlowsOfIPResponse, error) {
-	flowsOfIntegrationPackagesURL := conf.ApiURL + "/IntegrationPackages('" + packageName + "')/IntegrationDesigntimeArtifacts"
-	log.Println("GET ", flowsOfIntegrationPackagesURL)
-
-	request, err := http.NewRequest("GET", flowsOfIntegrationPackagesURL, nil)
-	if err != nil {
-		return nil, err
-	}
-	request.Header.Set("Accept", "application/json")
-
-	httpClient := getClient(conf)
-
-	response, err := httpClient.Do(request)
-
-	if err != nil {
-		return nil, err
-	}
-
-	defer response.Body.Close()
-
-	statusOk := response.StatusCode >= 200 && response.StatusCode < 300
-	if !statusOk {
-		body, _ := ioutil.ReadAll(response.Body)
-		return nil, fmt.Errorf("response Status: %s, response body: %s", response.Status, string(body))
-	}
-
 	var decodedRes model.FlowsOfIPResponse
-
-	if err := json.NewDecoder(response.Body).Decode(&decodedRes); err != nil {
+	err := getArtifactsOfIntegrationPackage(conf, FlowArtifact, packageName, &decodedRes)
+	if err != nil {
 		return nil, err
 	}
 
-	return &decodedRes, err
-
+	return &decodedRes, nil
 }
 
 //DownloadIntegrationPackage is the function to download all content of the integration package. The objects
@@ -399,6 +376,23 @@ func getCsrfTokenAndCookies(conf config.Configuration) (string, []*http.Cookie,
 	return csrfToken, cookies, nil
 }
 
+//checkResponseStatus returns ErrNotFound or ErrInvalidResponse with the response body when status is not 2xx
+func checkResponseStatus(response *http.Response) error {
+	statusOk := response.StatusCode >= 200 && response.StatusCode < 300
+	if statusOk {
+		return nil
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("cannot read body: %w", err)
+	}
+	err = ErrInvalidResponse
+	if response.StatusCode == http.StatusNotFound {
+		err = ErrNotFound
+	}
+	return fmt.Errorf("%w: %s", err, body)
+}
+
 func getTmpFileName() (string, error) {
 	tmpfile, err := os.CreateTemp("", "flow*.zip")
 	if err != nil {
@@ -420,164 +414,24 @@ func getTmpFileName() (string, error) {
 
 }
 
-func adjustDownloadedFlow(srcFlowID, destFlowID string, zipFile string) (newZipFile string, err error) {
-
-	fileDestinationFolder := zipFile[:len(zipFile)-4]
-
-	err = unzipFile(zipFile, fileDestinationFolder)
-	if err != nil {
-		return "", fmt.Errorf("error during uzipping file: %w", err)
-	}
-	log.Printf("file: %s has been unzipped to directory %s\n", zipFile, fileDestinationFolder)
-
-	manifestFile := filepath.Join(fileDestinationFolder, "META-INF/MANIFEST.MF")
-	//No line may be longer than 72 bytes (not characters), in its UTF8-encoded form.
-	//"Bundle-SymbolicName: " + 49 characters (flowName is in ASCII)
-	oldValue := ""
-	if len(srcFlowID) > 49 {
-		oldValue = "SymbolicName: " + srcFlowID[0:49] + "\r\n" + " " + srcFlowID[49:]
-	} else {
-		oldValue = "SymbolicName: " + srcFlowID
-	}
-
-	newValue := "SymbolicName: " + destFlowID
-	err = replaceFileContent(manifestFile, oldValue, newValue)
+//adjustDownloadedFlow sets the id and the name of the downloaded artifact in MANIFEST.MF and .project
+//and writes the archive to the new zip file. The name is kept when destName is empty.
+func adjustDownloadedFlow(zipFile string, destID string, destName string) (newZipFile string, err error) {
+	flow, err := iflow.ReadFile(zipFile)
 	if err != nil {
-		return "", fmt.Errorf("error updating META-INF/MANIFEST.MF file: %w", err)
+		return "", fmt.Errorf("error reading downloaded archive: %w", err)
 	}
 
-	projectFile := filepath.Join(fileDestinationFolder, ".project")
-	oldValue = "<name>" + srcFlowID + "</name>"
-	newValue = "<name>" + destFlowID + "</name>"
-	err = replaceFileContent(projectFile, oldValue, newValue)
-	if err != nil {
-		return "", fmt.Errorf("error updating .project file: %w", err)
+	flow.SetID(destID)
+	if destName != "" {
+		flow.SetName(destName)
 	}
 
-	newZipFile = fileDestinationFolder + "Copy.zip"
-	if err := zipSource(fileDestinationFolder+string(filepath.Separator), newZipFile); err != nil {
+	newZipFile = strings.TrimSuffix(zipFile, ".zip") + "Copy.zip"
+	if err := flow.WriteFile(newZipFile); err != nil {
 		return "", fmt.Errorf("error creating new zip file: %w", err)
 	}
 	log.Printf("new zip file has been created: %s", newZipFile)
 
 	return newZipFile, nil
 }
-
-func replaceFileContent(filename string, old string, new string) error {
-
-	oldContents, err := ioutil.ReadFile(filename)
-	if err != nil {
-		return fmt.Errorf("error reading file: %w", err)
-	}
-	newContents := strings.Replace(string(oldContents), old, new, -1)
-	err = ioutil.WriteFile(filename, []byte(newContents), fs.ModePerm)
-	if err != nil {
-		return fmt.Errorf("error updating file: %w", err)
-	}
-	log.Printf("File: %s has been updated.\n", filename)
-
-	return nil
-}
-
-func unzipFile(sourceFile, targetDirectory string) (err error) {
-
-	openedFile, err := zip.OpenReader(sourceFile)
-	if err != nil {
-		return err
-	}
-	defer openedFile.Close()
-
-	for _, file := range openedFile.File {
-		if strings.Contains(file.Name, "..") {
-			continue
-		}
-		filePath := filepath.Join(targetDirectory, file.Name)
-		//log.Println("unzipping file", filePath)
-		if file.FileInfo().IsDir() {
-			// create the directory
-			os.MkdirAll(filePath, os.ModePerm)
-			continue
-		}
-		if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
-			panic(err)
-		} else {
-			destinationFile, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, file.Mode())
-			if err != nil {
-				panic(err)
-			}
-			//Opening the file and copy it's contents
-			fileInArchive, err := file.Open()
-
-			if err != nil {
-				return err
-			}
-
-			if _, err := io.Copy(destinationFile, fileInArchive); err != nil {
-				panic(err)
-			}
-
-			destinationFile.Close()
-			fileInArchive.Close()
-		}
-
-	}
-
-	return nil
-}
-
-func zipSource(sourceDirectory, targetFile string) error {
-	// 1. Create a ZIP file and zip.Writer
-	f, err := os.Create(targetFile)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-
-	writer := zip.NewWriter(f)
-	defer writer.Close()
-
-	// 2. Go through all the files of the source
-	return filepath.Walk(sourceDirectory, func(path string, info os.FileInfo, err error) error {
-		//log.Println(path)
-		if err != nil {
-			return err
-		}
-
-		// 3. Create a local file header
-		header, err := zip.FileInfoHeader(info)
-		if err != nil {
-			return err
-		}
-
-		// set compression
-		header.Method = zip.Deflate
-
-		// 4. Set relative path of a file as the header name
-		header.Name, err = filepath.Rel(filepath.Dir(sourceDirectory), path)
-		if err != nil {
-			return err
-		}
-		if info.IsDir() {
-			header.Name += "/"
-		}
-
-		// 5. Create writer for the file header and save content of the file
-		headerWriter, err := writer.CreateHeader(header)
-		if err != nil {
-			return err
-		}
-
-		if info.IsDir() {
-			return nil
-		}
-
-		f, err := os.Open(path)
-		if err != nil {
-			return err
-		}
-		defer f.Close()
-
-		_, err = io.Copy(headerWriter, f)
-		return err
-	})
-}
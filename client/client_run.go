@@ -33,7 +33,7 @@ func RunInspectIntegrationPackage(conf config.Configuration, packageID string) {
 	// 	log.Fatal(err)
 	// }
 
-	resp, err := InspectIntegrationPackage(conf, packageID)
+	resp, err := InspectIntegrationPackageWithTags(conf, packageID)
 	if err != nil {
 		log.Fatal("Error in InspectIntegrationPackage: ", err)
 	}
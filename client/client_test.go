@@ -1,6 +1,7 @@
 package client_test
 
 import (
+	"archive/zip"
 	"bytes"
 	"encoding/base64"
 	"errors"
@@ -715,10 +716,14 @@ func TestCopyFlow(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			url, cleanup := mockServer(
 				func(w http.ResponseWriter, r *http.Request) {
-					if r.Method == "POST" {
+					switch {
+					case r.Method == "POST":
 						w.WriteHeader(tc.createResp.Status)
 						fmt.Fprintln(w, tc.createResp.Body)
-					} else {
+					case strings.HasSuffix(r.URL.Path, "$value") && tc.resp.Status == http.StatusOK:
+						w.WriteHeader(http.StatusOK)
+						w.Write(newTestArtifactZip(t, tc.srcFlowId, nil))
+					default:
 						w.WriteHeader(tc.resp.Status)
 						fmt.Fprintln(w, tc.resp.Body)
 					}
@@ -941,3 +946,35 @@ func mockServer(h http.HandlerFunc) (string, func()) {
 		ts.Close()
 	}
 }
+
+func newTestArtifactZip(t *testing.T, id string, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	allFiles := map[string]string{
+		"META-INF/MANIFEST.MF": "Manifest-Version: 1.0\r\nBundle-SymbolicName: " + id + "\r\nBundle-Name: " + id + "\r\n",
+		".project":             "<projectDescription><name>" + id + "</name></projectDescription>",
+	}
+	for name, content := range files {
+		allFiles[name] = content
+	}
+	for name, content := range allFiles {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func encodeBase64(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
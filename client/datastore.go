@@ -0,0 +1,180 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func dataStoreKey(store model.DataStore) string {
+	return "DataStoreName=" + odataKey(store.DataStoreName) +
+		",IntegrationFlow=" + odataKey(store.IntegrationFlow) +
+		",Type=" + odataKey(store.Type)
+}
+
+func dataStoreEntryURL(conf config.Configuration, store model.DataStore, entryID string) string {
+	return conf.ApiURL + "/DataStoreEntries(Id=" + odataKey(entryID) + "," + dataStoreKey(store) + ")"
+}
+
+//RunGetDataStores - call the function GetDataStores
+func RunGetDataStores(out io.Writer, conf config.Configuration) {
+	resp, err := GetDataStores(conf)
+	if err != nil {
+		log.Fatal("Error in GetDataStores:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetDataStores - get list of the data stores of the tenant
+func GetDataStores(conf config.Configuration) (*model.DataStoresResponse, error) {
+	var decodedRes model.DataStoresResponse
+	if err := getEntity(conf, conf.ApiURL+"/DataStores", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//FindDataStore returns the data store with the name. The integration flow is needed
+//only when stores with the same name exist for more integration flows.
+func FindDataStore(conf config.Configuration, name string, flowID string) (model.DataStore, error) {
+	stores, err := GetDataStores(conf)
+	if err != nil {
+		return model.DataStore{}, err
+	}
+
+	var found []model.DataStore
+	for _, s := range stores.D.Results {
+		if s.DataStoreName != name {
+			continue
+		}
+		if flowID != "" && s.IntegrationFlow != flowID {
+			continue
+		}
+		found = append(found, s)
+	}
+
+	switch len(found) {
+	case 0:
+		return model.DataStore{}, fmt.Errorf("%w: data store %s", ErrNotFound, name)
+	case 1:
+		return found[0], nil
+	}
+	return model.DataStore{}, fmt.Errorf("%w: data store %s exists for more integration flows, use --flow flag", ErrInvalid, name)
+}
+
+//RunGetDataStoreEntries - call the function GetDataStoreEntries
+func RunGetDataStoreEntries(out io.Writer, conf config.Configuration, storeName string, flowID string) {
+	store, err := FindDataStore(conf, storeName, flowID)
+	if err != nil {
+		log.Fatal("Error in FindDataStore:\n", err)
+	}
+	resp, err := GetDataStoreEntries(conf, store)
+	if err != nil {
+		log.Fatal("Error in GetDataStoreEntries:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetDataStoreEntries - get list of the entries of the data store
+func GetDataStoreEntries(conf config.Configuration, store model.DataStore) (*model.DataStoreEntriesResponse, error) {
+	var decodedRes model.DataStoreEntriesResponse
+	if err := getEntity(conf, conf.ApiURL+"/DataStores("+dataStoreKey(store)+")/Entries", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunGetDataStoreEntry - print the data store entry, the payload is saved when saveFile is set
+func RunGetDataStoreEntry(out io.Writer, conf config.Configuration, storeName string, flowID string, entryID string, saveFile string) {
+	store, err := FindDataStore(conf, storeName, flowID)
+	if err != nil {
+		log.Fatal("Error in FindDataStore:\n", err)
+	}
+
+	if saveFile == "" {
+		var decodedRes model.DataStoreEntryByIdResponse
+		if err := getEntity(conf, dataStoreEntryURL(conf, store, entryID), &decodedRes); err != nil {
+			log.Fatal("Error in GetDataStoreEntry:\n", err)
+		}
+		decodedRes.Print(out)
+		return
+	}
+
+	outputContent, err := os.OpenFile(saveFile, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		log.Fatal("Error Openning file:\n", err)
+	}
+	defer outputContent.Close()
+
+	err = DownloadDataStoreEntry(out, conf, store, entryID, outputContent)
+	if err != nil {
+		log.Fatal("Error in DownloadDataStoreEntry: ", err)
+	}
+}
+
+//DownloadDataStoreEntry - download the payload of the data store entry, the content is zip archive with body and headers
+func DownloadDataStoreEntry(out io.Writer, conf config.Configuration, store model.DataStore, entryID string, outputContent io.Writer) error {
+	content, err := getEntityContent(conf, dataStoreEntryURL(conf, store, entryID)+"/$value", "")
+	if err != nil {
+		return err
+	}
+
+	n, err := saveBodyContent(outputContent, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Content downloaded.\nnumber of bytes: %d\n", n)
+	return nil
+}
+
+//RunDeleteDataStore - call the function DeleteFromDataStore
+func RunDeleteDataStore(out io.Writer, conf config.Configuration, storeName string, flowID string, entryID string, all bool) {
+	err := DeleteFromDataStore(out, conf, storeName, flowID, entryID, all)
+	if err != nil {
+		log.Fatal("Error in DeleteDataStore:\n", err)
+	}
+}
+
+//DeleteFromDataStore deletes the entry of the data store, the whole data store with all its entries is deleted
+//only when all is set and the entry id is empty
+func DeleteFromDataStore(out io.Writer, conf config.Configuration, storeName string, flowID string, entryID string, all bool) error {
+	if entryID == "" && !all {
+		return fmt.Errorf("%w: entry id not set, use --all to delete the data store %s with all its entries", ErrInvalid, storeName)
+	}
+	if entryID != "" && all {
+		return fmt.Errorf("%w: entry id and --all are mutually exclusive", ErrInvalid)
+	}
+
+	store, err := FindDataStore(conf, storeName, flowID)
+	if err != nil {
+		return err
+	}
+	if all {
+		return DeleteDataStore(out, conf, store)
+	}
+	return DeleteDataStoreEntry(out, conf, store, entryID)
+}
+
+//DeleteDataStore - delete the data store with all its entries
+func DeleteDataStore(out io.Writer, conf config.Configuration, store model.DataStore) error {
+	if err := sendEntity(conf, "DELETE", conf.ApiURL+"/DataStores("+dataStoreKey(store)+")", nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Data store: %s deleted\n", store.DataStoreName)
+	return nil
+}
+
+//DeleteDataStoreEntry - delete the entry of the data store
+func DeleteDataStoreEntry(out io.Writer, conf config.Configuration, store model.DataStore, entryID string) error {
+	if err := sendEntity(conf, "DELETE", dataStoreEntryURL(conf, store, entryID), nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Data store entry: %s/%s deleted\n", store.DataStoreName, entryID)
+	return nil
+}
@@ -0,0 +1,144 @@
+package client_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func TestFindDataStore(t *testing.T) {
+	body := `{"d": {"results": [
+		{"DataStoreName": "Orders", "IntegrationFlow": "PurchaseOrder", "Type": "", "Visibility": "Integration Flow", "NumberOfMessages": "2"},
+		{"DataStoreName": "Orders", "IntegrationFlow": "SalesOrder", "Type": "", "Visibility": "Integration Flow", "NumberOfMessages": 5},
+		{"DataStoreName": "Invoices", "IntegrationFlow": "Invoice", "Type": "", "Visibility": "Global", "NumberOfMessages": 1}
+	]}}`
+
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, body)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	testCases := []struct {
+		name     string
+		store    string
+		flow     string
+		expFlow  string
+		expError error
+	}{
+		{name: "unique", store: "Invoices", expFlow: "Invoice"},
+		{name: "withFlow", store: "Orders", flow: "SalesOrder", expFlow: "SalesOrder"},
+		{name: "ambiguous", store: "Orders", expError: client.ErrInvalid},
+		{name: "missing", store: "Payments", expError: client.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store, err := client.FindDataStore(conf, tc.store, tc.flow)
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if store.IntegrationFlow != tc.expFlow {
+				t.Errorf("Expected integration flow %s, got %s", tc.expFlow, store.IntegrationFlow)
+			}
+		})
+	}
+}
+
+func TestDownloadDataStoreEntry(t *testing.T) {
+	var requestPath string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			requestPath = r.URL.Path
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("PK payload"))
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	store := model.DataStore{DataStoreName: "Orders", IntegrationFlow: "PurchaseOrder"}
+
+	var out, content bytes.Buffer
+	err := client.DownloadDataStoreEntry(&out, conf, store, "4711", &content)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	expPath := "/DataStoreEntries(Id='4711',DataStoreName='Orders',IntegrationFlow='PurchaseOrder',Type='')/$value"
+	if requestPath != expPath {
+		t.Errorf("Expected request to %s, got %s", expPath, requestPath)
+	}
+	if content.String() != "PK payload" {
+		t.Errorf("Unexpected content %q", content.String())
+	}
+}
+
+func TestDeleteFromDataStore(t *testing.T) {
+	var requests []string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			if r.Method == "DELETE" {
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"d": {"results": [{"DataStoreName": "Invoices", "IntegrationFlow": "Invoice", "Type": "", "Visibility": "Global", "NumberOfMessages": 1}]}}`)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	testCases := []struct {
+		name      string
+		entryID   string
+		all       bool
+		expError  error
+		expDelete string
+	}{
+		{name: "entry", entryID: "0001", expDelete: "DELETE /DataStoreEntries(Id='0001',DataStoreName='Invoices',IntegrationFlow='Invoice',Type='')"},
+		{name: "all", all: true, expDelete: "DELETE /DataStores(DataStoreName='Invoices',IntegrationFlow='Invoice',Type='')"},
+		{name: "missingEntry", expError: client.ErrInvalid},
+		{name: "entryAndAll", entryID: "0001", all: true, expError: client.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests = nil
+			var out bytes.Buffer
+			err := client.DeleteFromDataStore(&out, conf, "Invoices", "", tc.entryID, tc.all)
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				if len(requests) != 0 {
+					t.Errorf("Expected no requests, got %v", requests)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if len(requests) == 0 || requests[len(requests)-1] != tc.expDelete {
+				t.Errorf("Expected request %s, got %v", tc.expDelete, requests)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//RunGetServiceEndpoints - call the function GetServiceEndpoints
+func RunGetServiceEndpoints(out io.Writer, conf config.Configuration, flowID string) {
+	resp, err := GetServiceEndpoints(conf, flowID)
+	if err != nil {
+		log.Fatal("Error in GetServiceEndpoints:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetServiceEndpoints - get list of the service endpoints with entry points and API definitions, only endpoints of the flow when flowID is set
+func GetServiceEndpoints(conf config.Configuration, flowID string) (*model.ServiceEndpointsResponse, error) {
+	var decodedRes model.ServiceEndpointsResponse
+	if err := getEntity(conf, conf.ApiURL+"/ServiceEndpoints?$expand=EntryPoints,ApiDefinitions", &decodedRes); err != nil {
+		return nil, err
+	}
+	if flowID == "" {
+		return &decodedRes, nil
+	}
+
+	var filtered model.ServiceEndpointsResponse
+	for _, e := range decodedRes.D.Results {
+		// the id of the endpoint is the flow id followed by $endpointAddress=
+		if e.Name == flowID || strings.HasPrefix(e.Id, flowID+"$") {
+			filtered.D.Results = append(filtered.D.Results, e)
+		}
+	}
+	return &filtered, nil
+}
+
+//RunDownloadServiceEndpointDefinition - download the definition to the file or to the output
+func RunDownloadServiceEndpointDefinition(out io.Writer, conf config.Configuration, endpointID string, outputFile string) {
+	content, err := DownloadServiceEndpointDefinition(conf, endpointID)
+	if err != nil {
+		log.Fatal("Error in DownloadServiceEndpointDefinition:\n", err)
+	}
+
+	if outputFile == "" {
+		out.Write(content)
+		return
+	}
+	if err := os.WriteFile(outputFile, content, 0666); err != nil {
+		log.Fatal("Error writing file:\n", err)
+	}
+	fmt.Fprintf(out, "Definition of the endpoint: %s saved to %s\n", endpointID, outputFile)
+}
+
+//DownloadServiceEndpointDefinition - download the WSDL or API definition of the service endpoint
+func DownloadServiceEndpointDefinition(conf config.Configuration, endpointID string) ([]byte, error) {
+	endpoints, err := GetServiceEndpoints(conf, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range endpoints.D.Results {
+		if e.Id != endpointID {
+			continue
+		}
+		if len(e.ApiDefinitions.Results) == 0 {
+			return nil, fmt.Errorf("%w: endpoint %s has no definition", ErrNotFound, endpointID)
+		}
+		definitionURL := e.ApiDefinitions.Results[0].Url
+		if !strings.HasPrefix(definitionURL, "http") {
+			definitionURL = conf.ApiURL + "/" + strings.TrimPrefix(definitionURL, "/")
+		}
+		return getEntityContent(conf, definitionURL, "")
+	}
+	return nil, fmt.Errorf("%w: endpoint %s", ErrNotFound, endpointID)
+}
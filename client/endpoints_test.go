@@ -0,0 +1,59 @@
+package client_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestDownloadServiceEndpointDefinition(t *testing.T) {
+	var serverURL string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/ServiceEndpoints":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, `{"d": {"results": [
+					{"Id": "Orders$endpointAddress=orders", "Name": "Orders", "Protocol": "SOAP",
+					 "EntryPoints": {"results": [{"Name": "Orders", "Url": "https://tenant/cxf/orders", "Type": "PROD"}]},
+					 "ApiDefinitions": {"results": [{"Name": "WSDL", "Url": "%s/wsdl/orders"}]}},
+					{"Id": "Invoices$endpointAddress=invoices", "Name": "Invoices", "Protocol": "REST",
+					 "EntryPoints": {"results": [{"Name": "Invoices", "Url": "https://tenant/http/invoices", "Type": "PROD"}]},
+					 "ApiDefinitions": {"results": []}}
+				]}}`, serverURL)
+			case "/wsdl/orders":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, "<wsdl:definitions/>")
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+	defer cleanup()
+	serverURL = url
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	resp, err := client.GetServiceEndpoints(conf, "Invoices")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if len(resp.D.Results) != 1 || resp.D.Results[0].EntryPoints.Results[0].Url != "https://tenant/http/invoices" {
+		t.Errorf("Unexpected endpoints %+v", resp.D.Results)
+	}
+
+	content, err := client.DownloadServiceEndpointDefinition(conf, "Orders$endpointAddress=orders")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if string(content) != "<wsdl:definitions/>" {
+		t.Errorf("Unexpected definition %q", content)
+	}
+
+	_, err = client.DownloadServiceEndpointDefinition(conf, "Invoices$endpointAddress=invoices")
+	if err == nil {
+		t.Errorf("Expected error for endpoint without definition")
+	}
+}
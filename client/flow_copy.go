@@ -3,7 +3,6 @@ package client
 import (
 	"io"
 	"log"
-	"os"
 
 	"github.com/tobiaszgithub/cig/config"
 )
@@ -18,52 +17,5 @@ func RunCopyFlow(out io.Writer, conf config.Configuration, srcFlowID string, des
 
 //CopyFlow is the function to copy flows in the same system
 func CopyFlow(out io.Writer, conf config.Configuration, srcFlowID string, destFlowID string, destFlowName string, destPackageID string) error {
-	version := "active"
-	srcFlow, err := InspectFlow(conf, srcFlowID, version)
-	if err != nil {
-		return err
-	}
-
-	tmpFileName, err := getTmpFileName()
-	if err != nil {
-		return err
-	}
-	defer os.Remove(tmpFileName)
-
-	outputContent, err := os.OpenFile(tmpFileName, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
-	if err != nil {
-		log.Fatal("Error Openning file:\n", err)
-	}
-	defer outputContent.Close()
-
-	//var out bytes.Buffer
-	err = DownloadFlow(out, conf, srcFlowID, version, outputContent)
-	if err != nil {
-		return err
-	}
-	//fmt.Println(out.String())
-
-	if destFlowName == "" {
-		destFlowName = srcFlow.D.Name
-	}
-
-	if destPackageID == "" {
-		destPackageID = srcFlow.D.PackageID
-	}
-
-	tmpFileContent, err := os.Open(tmpFileName)
-	if err != nil {
-		return err
-	}
-	defer tmpFileContent.Close()
-
-	createResp, err := CreateFlow(conf, destFlowName, destFlowID, destPackageID, tmpFileContent)
-	if err != nil {
-		return err
-	}
-
-	//log.Print(createResp.Print())
-	createResp.Print(out)
-
-	return nil
+	return CopyArtifact(out, conf, FlowArtifact, srcFlowID, destFlowID, destFlowName, destPackageID)
 }
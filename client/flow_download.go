@@ -1,10 +1,8 @@
 package client
 
 import (
-	"fmt"
 	"io"
 	"log"
-	"net/http"
 	"os"
 
 	"github.com/tobiaszgithub/cig/config"
@@ -30,44 +28,7 @@ func RunDownloadFlow(out io.Writer, conf config.Configuration, flowID string, ve
 
 //DownloadFlow is the function to download integration flow content
 func DownloadFlow(out io.Writer, conf config.Configuration, flowID string, version string, outputContent io.Writer) error {
-	flowURL := conf.ApiURL + "/IntegrationDesigntimeArtifacts(Id='" + flowID + "',Version='active')/$value"
-	log.Println("GET ", flowURL)
-	request, err := http.NewRequest("GET", flowURL, nil)
-	if err != nil {
-		return err
-	}
-	request.Header.Set("Accept", "application/json")
-
-	httpClient := getClient(conf)
-
-	response, err := httpClient.Do(request)
-	if err != nil {
-		return fmt.Errorf("%w: %s", ErrConnection, err)
-	}
-	defer response.Body.Close()
-
-	statusOk := response.StatusCode >= 200 && response.StatusCode < 300
-	if !statusOk {
-		body, err := io.ReadAll(response.Body)
-		if err != nil {
-			return fmt.Errorf("cannot read body: %w", err)
-		}
-		err = ErrInvalidResponse
-		if response.StatusCode == http.StatusNotFound {
-			err = ErrNotFound
-		}
-		return fmt.Errorf("%w: %s", err, body)
-	}
-
-	n, err := saveBodyContent(outputContent, response.Body)
-	if err != nil {
-		return err
-	}
-
-	output := "Content downloaded.\n"
-	output += fmt.Sprintf("number of bytes: %d", n)
-	fmt.Fprintf(out, "%s", output)
-	return nil
+	return DownloadArtifact(out, conf, FlowArtifact, flowID, version, outputContent)
 }
 
 func saveBodyContent(outputContent io.Writer, src io.Reader) (writtenBytes int64, err error) {
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/iflow"
+	"github.com/tobiaszgithub/cig/lint"
+)
+
+//RunLintFlow - call the function LintFlow and write the findings. The program exits with ErrThreshold
+//when there are findings with the severity failOn or higher, failOn none disables the check.
+func RunLintFlow(out io.Writer, conf config.Configuration, source string, configFile string, format string, failOn string, outputFile string) {
+	threshold, err := parseFailOn(failOn)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	flow, findings, err := LintFlow(conf, source, configFile)
+	if err != nil {
+		log.Fatal("Error in LintFlow:\n", err)
+	}
+
+	if outputFile != "" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			log.Fatal("Error creating file:\n", err)
+		}
+		defer f.Close()
+		out = f
+	}
+	if err := lint.WriteSource(out, flow.ID(), flowSourceDir(source), findings, format); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := checkThreshold(findings, threshold); err != nil {
+		log.Fatal(err)
+	}
+}
+
+//parseFailOn returns the severity of the --fail-on flag, the severity is empty when failOn is none
+func parseFailOn(failOn string) (lint.Severity, error) {
+	if failOn == "none" {
+		return "", nil
+	}
+	return lint.ParseSeverity(failOn)
+}
+
+//checkThreshold returns ErrThreshold when there are findings with the severity threshold or higher, the empty threshold disables the check
+func checkThreshold(findings []lint.Finding, threshold lint.Severity) error {
+	if threshold == "" {
+		return nil
+	}
+	if exceeded := lint.AtLeast(findings, threshold); len(exceeded) > 0 {
+		return fmt.Errorf("%w: %d findings with severity %s or higher", ErrThreshold, len(exceeded), threshold)
+	}
+	return nil
+}
+
+//LintFlow checks the flow with the rule set of the configuration file, all rules are checked with the default settings
+//when configFile is empty. The source is the directory created by flow pull, the zip file or the id of the flow in the tenant.
+func LintFlow(conf config.Configuration, source string, configFile string) (*iflow.Flow, []lint.Finding, error) {
+	var lintConfig *lint.Config
+	if configFile != "" {
+		var err error
+		if lintConfig, err = lint.ReadConfig(configFile); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	flow, err := loadFlow(conf, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	findings, err := lint.Lint(flow, lintConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return flow, findings, nil
+}
+
+//IsLocalFlowSource reports whether the source is the directory or the zip file, not the id of the flow in the tenant
+func IsLocalFlowSource(source string) bool {
+	_, err := os.Stat(source)
+	return err == nil
+}
+
+//flowSourceDir returns the slash separated path of the source directory relative to the working directory,
+//the path is empty when the source is the zip file or the id of the flow in the tenant
+func flowSourceDir(source string) string {
+	info, err := os.Stat(source)
+	if err != nil || !info.IsDir() {
+		return ""
+	}
+	dir, err := filepath.Abs(source)
+	if err != nil {
+		return filepath.ToSlash(filepath.Clean(source))
+	}
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, dir); err == nil {
+			dir = rel
+		}
+	}
+	if dir == "." {
+		return ""
+	}
+	return filepath.ToSlash(dir)
+}
+
+//loadFlow reads the flow from the directory or the zip file, otherwise the flow with the id is downloaded from the tenant
+func loadFlow(conf config.Configuration, source string) (*iflow.Flow, error) {
+	if IsLocalFlowSource(source) {
+		return readFlowSource(source)
+	}
+	return downloadWorkspaceArtifact(conf, FlowArtifact, source)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"io"
+	"log"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/iflow"
+	"github.com/tobiaszgithub/cig/lint"
+)
+
+//RunCheckFlowParameters - call the function CheckFlowParameters and write the findings. The program exits with ErrThreshold
+//when there are findings with the severity failOn or higher, failOn none disables the check.
+func RunCheckFlowParameters(out io.Writer, conf config.Configuration, source string, version string, local bool, format string, failOn string) {
+	threshold, err := parseFailOn(failOn)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	flow, findings, err := CheckFlowParameters(conf, source, version, local)
+	if err != nil {
+		log.Fatal("Error in CheckFlowParameters:\n", err)
+	}
+
+	if err := lint.WriteSource(out, flow.ID(), flowSourceDir(source), findings, format); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := checkThreshold(findings, threshold); err != nil {
+		log.Fatal(err)
+	}
+}
+
+//CheckFlowParameters cross-references the placeholders of the flow, parameters.prop, parameters.propdef and the configuration
+//parameters of the flow in the version of the tenant. The configuration of the tenant is not checked when local is true.
+func CheckFlowParameters(conf config.Configuration, source string, version string, local bool) (*iflow.Flow, []lint.Finding, error) {
+	flow, err := loadFlow(conf, source)
+	if err != nil {
+		return nil, nil, err
+	}
+	if local {
+		return flow, lint.CheckParameters(flow, nil), nil
+	}
+
+	configs, err := GetFlowConfigs(conf, flow.ID(), version)
+	if err != nil {
+		return nil, nil, err
+	}
+	tenant := []lint.Parameter{}
+	for _, c := range configs.D.Results {
+		tenant = append(tenant, lint.Parameter{Key: c.ParameterKey, Value: c.ParameterValue, Type: c.DataType})
+	}
+	return flow, lint.CheckParameters(flow, tenant), nil
+}
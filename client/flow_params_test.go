@@ -0,0 +1,67 @@
+package client_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestCheckFlowParameters(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"META-INF/MANIFEST.MF": "Manifest-Version: 1.0\r\nBundle-SymbolicName: PurchaseOrder\r\n\r\n",
+		"src/main/resources/scenarioflows/integrationflow/PurchaseOrder.iflw": "<bpmn2:definitions xmlns:bpmn2=\"http://www.omg.org/spec/BPMN/20100524/MODEL\">\n" +
+			"<value>{{Address}}</value>\n<value>{{Timeout}}</value>\n</bpmn2:definitions>\n",
+		"src/main/resources/parameters.prop": "Address=https://example.com\nTimeout=60\n",
+		"src/main/resources/parameters.propdef": "<parameters>\n" +
+			"<parameter><key>Address</key><type>xsd:string</type></parameter>\n" +
+			"<parameter><key>Timeout</key><type>xsd:integer</type></parameter>\n</parameters>\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(path), os.ModePerm)
+		os.WriteFile(path, []byte(content), 0666)
+	}
+
+	var requestURI string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			requestURI = r.URL.RequestURI()
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"d":{"results":[
+				{"ParameterKey":"Address","ParameterValue":"https://example.com","DataType":"xsd:string"},
+				{"ParameterKey":"Timeout","ParameterValue":"one minute","DataType":"xsd:integer"}]}}`))
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	flow, findings, err := client.CheckFlowParameters(conf, dir, "active", true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if flow.ID() != "PurchaseOrder" || len(findings) != 0 || requestURI != "" {
+		t.Errorf("Expected no findings and no request, got %+v, request %q", findings, requestURI)
+	}
+
+	_, findings, err = client.CheckFlowParameters(conf, dir, "active", false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if requestURI != "/IntegrationDesigntimeArtifacts(Id='PurchaseOrder',Version='active')/Configurations" {
+		t.Errorf("Unexpected request %q", requestURI)
+	}
+	var messages []string
+	for _, f := range findings {
+		messages = append(messages, f.Message)
+	}
+	exp := []string{"value one minute of parameter Timeout configured in the tenant is not valid xsd:integer"}
+	if !reflect.DeepEqual(messages, exp) {
+		t.Errorf("Expected findings %q, got %q", exp, messages)
+	}
+}
@@ -0,0 +1,194 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/iflow"
+)
+
+//propertiesTimestamp - the date comment written by java.util.Properties.store, e.g. #Mon Oct 10 10:00:00 UTC 2022
+var propertiesTimestamp = regexp.MustCompile(`^#[A-Z][a-z]{2} [A-Z][a-z]{2} [ 0-9]?[0-9] [0-9]{2}:[0-9]{2}:[0-9]{2} [^ ]+ [0-9]{4}$`)
+
+//RunPullFlow - call the function PullFlow
+func RunPullFlow(out io.Writer, conf config.Configuration, flowID string, version string, dir string) {
+	if dir == "" {
+		dir = flowID
+	}
+
+	err := PullFlow(out, conf, flowID, version, dir)
+	if err != nil {
+		log.Fatal("Error in PullFlow: ", err)
+	}
+}
+
+//PullFlow downloads the integration flow and extracts it into the directory
+func PullFlow(out io.Writer, conf config.Configuration, flowID string, version string, dir string) error {
+	var content bytes.Buffer
+	err := DownloadArtifact(io.Discard, conf, FlowArtifact, flowID, version, &content)
+	if err != nil {
+		return err
+	}
+
+	flow, err := iflow.Read(content.Bytes())
+	if err != nil {
+		return err
+	}
+
+	n, err := extractFlow(flow, dir)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Integration Flow: %s pulled to directory %s, number of files: %d\n", flowID, dir, n)
+	return nil
+}
+
+//extractFlow writes the files of the flow to the directory. The directory has to be empty or contain the same flow,
+//the files of the previous pull which are no longer part of the archive are removed.
+func extractFlow(flow *iflow.Flow, dir string) (int, error) {
+	previous, err := pulledFlowFiles(flow.ID(), dir)
+	if err != nil {
+		return 0, err
+	}
+
+	written := map[string]bool{}
+	n := 0
+	for _, f := range flow.Files {
+		filePath := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if f.IsDir() {
+			if err := os.MkdirAll(filePath, os.ModePerm); err != nil {
+				return n, err
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+			return n, err
+		}
+		if err := os.WriteFile(filePath, normalizeFlowFile(f.Name, f.Content), 0666); err != nil {
+			return n, err
+		}
+		written[f.Name] = true
+		n++
+	}
+
+	for name, path := range previous {
+		if written[name] {
+			continue
+		}
+		log.Printf("file: %s is not part of the flow, removing", path)
+		if err := os.Remove(path); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+//flowRootEntries - entries of the root of the flow archive, other files of the directory like README.md are not part of the flow
+var flowRootEntries = map[string]bool{iflow.ProjectPath: true, "metainfo.prop": true, "META-INF": true, "src": true}
+
+//pulledFlowFiles returns the paths of the files of the flow pulled before into the directory by the name in the archive.
+//The directory which contains files and not the flow with the id is refused, hidden files like .gitignore are allowed.
+func pulledFlowFiles(flowID string, dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]string{}
+	err = walkFlowDir(dir, func(name string, path string, d fs.DirEntry) error {
+		files[name] = path
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	manifestPath, ok := files[iflow.ManifestPath]
+	if !ok {
+		for _, e := range entries {
+			if !strings.HasPrefix(e.Name(), ".") {
+				return nil, fmt.Errorf("%w: directory %s is not empty and does not contain an integration flow", ErrInvalid, dir)
+			}
+		}
+		return files, nil
+	}
+	content, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := iflow.ParseManifest(content)
+	if err != nil {
+		return nil, err
+	}
+	if id := manifest.SymbolicName(); id != flowID {
+		return nil, fmt.Errorf("%w: directory %s contains the integration flow %s, not %s", ErrInvalid, dir, id, flowID)
+	}
+	return files, nil
+}
+
+//walkFlowDir calls fn for the files of the flow in the directory with the slash separated name in the archive.
+//Only the entries of flowRootEntries are walked, hidden entries like .git below them are skipped.
+func walkFlowDir(dir string, fn func(name string, path string, d fs.DirEntry) error) error {
+	root := filepath.Clean(dir)
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+		skip := strings.HasPrefix(d.Name(), ".")
+		if filepath.Dir(path) == root {
+			skip = !flowRootEntries[d.Name()]
+		}
+		if skip {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		return fn(filepath.ToSlash(rel), path, d)
+	})
+}
+
+//normalizeFlowFile converts the line endings of the text files to LF and removes the timestamp comments
+//of the properties files, so the pulled flow does not change between downloads.
+//MANIFEST.MF keeps CRLF line endings required by the JAR specification.
+func normalizeFlowFile(name string, content []byte) []byte {
+	if name == iflow.ManifestPath || bytes.IndexByte(content, 0) >= 0 || !utf8.Valid(content) {
+		return content
+	}
+
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	if strings.HasSuffix(name, ".prop") {
+		lines := strings.Split(text, "\n")
+		kept := lines[:0]
+		for _, line := range lines {
+			if !propertiesTimestamp.MatchString(line) {
+				kept = append(kept, line)
+			}
+		}
+		text = strings.Join(kept, "\n")
+	}
+	return []byte(text)
+}
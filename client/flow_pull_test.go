@@ -0,0 +1,245 @@
+package client_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestPullFlow(t *testing.T) {
+	content := newTestArtifactZip(t, "PurchaseOrder", map[string]string{
+		"metainfo.prop": "#Mon Oct 10 10:00:00 UTC 2022\r\ndescription=Orders\r\n",
+		"src/main/resources/script/script1.groovy": "def Message processData(Message message) {\r\n\treturn message\r\n}\r\n",
+	})
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write(content)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	dir := t.TempDir()
+	previous := map[string]string{
+		"META-INF/MANIFEST.MF":                     "Manifest-Version: 1.0\r\nBundle-SymbolicName: PurchaseOrder\r\n",
+		"src/main/resources/script/removed.groovy": "removed",
+		".gitignore":                               "*.zip\n",
+		"README.md":                                "# PurchaseOrder\n",
+		".git/HEAD":                                "ref: refs/heads/main\n",
+	}
+	for name, content := range previous {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+		os.WriteFile(filePath, []byte(content), 0666)
+	}
+	stale := filepath.Join(dir, "src", "main", "resources", "script", "removed.groovy")
+
+	var out bytes.Buffer
+	if err := client.PullFlow(&out, conf, "PurchaseOrder", "active", dir); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	expFiles := map[string]string{
+		"META-INF/MANIFEST.MF":                     "Manifest-Version: 1.0\r\nBundle-SymbolicName: PurchaseOrder\r\nBundle-Name: PurchaseOrder\r\n",
+		"metainfo.prop":                            "description=Orders\n",
+		"src/main/resources/script/script1.groovy": "def Message processData(Message message) {\n\treturn message\n}\n",
+	}
+	for name, exp := range expFiles {
+		got, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatalf("Expected no error, got %q.", err)
+		}
+		if string(got) != exp {
+			t.Errorf("Expected %s:\n%q\ngot:\n%q", name, exp, got)
+		}
+	}
+	if _, err := os.Stat(stale); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("File %s should be removed", stale)
+	}
+	for _, name := range []string{".gitignore", ".git/HEAD", "README.md"} {
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+			t.Errorf("File %s should be kept: %s", name, err)
+		}
+	}
+	if !strings.Contains(out.String(), "number of files: 4") {
+		t.Errorf("Unexpected output %q", out.String())
+	}
+}
+
+func TestPullFlowForeignDirectory(t *testing.T) {
+	content := newTestArtifactZip(t, "PurchaseOrder", nil)
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write(content)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	testCases := []struct {
+		name  string
+		files map[string]string
+	}{
+		{name: "repository", files: map[string]string{"README.md": "# flows\n", ".gitignore": "*.zip\n"}},
+		{name: "otherFlow", files: map[string]string{"META-INF/MANIFEST.MF": "Manifest-Version: 1.0\r\nBundle-SymbolicName: Invoice\r\n", "README.md": "# Invoice\n"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tc.files {
+				filePath := filepath.Join(dir, filepath.FromSlash(name))
+				os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+				os.WriteFile(filePath, []byte(content), 0666)
+			}
+
+			var out bytes.Buffer
+			err := client.PullFlow(&out, conf, "PurchaseOrder", "active", dir)
+			if !errors.Is(err, client.ErrInvalid) {
+				t.Errorf("Expected error %q, got %q.", client.ErrInvalid, err)
+			}
+			for name, exp := range tc.files {
+				got, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+				if err != nil || string(got) != exp {
+					t.Errorf("File %s should be kept unchanged, got %q: %v", name, got, err)
+				}
+			}
+			if _, err := os.Stat(filepath.Join(dir, ".project")); !errors.Is(err, os.ErrNotExist) {
+				t.Errorf("Flow should not be extracted into the directory")
+			}
+		})
+	}
+}
+
+func TestPushFlow(t *testing.T) {
+	testCases := []struct {
+		name         string
+		packageID    string
+		exists       bool
+		expMethod    string
+		expPackageID string
+		expError     error
+	}{
+		{name: "update", exists: true, expMethod: "PUT"},
+		{name: "create", packageID: "POscenerio", expMethod: "POST", expPackageID: "POscenerio"},
+		{name: "createWithoutPackage", expError: client.ErrInvalid},
+	}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"META-INF/MANIFEST.MF": "Manifest-Version: 1.0\r\nBundle-SymbolicName: PurchaseOrder; singleton:=true\r\nBundle-Name: Purchase Order\r\n\r\n",
+		".project":             "<projectDescription><name>PurchaseOrder</name></projectDescription>",
+		"src/main/resources/script/script1.groovy": "def Message processData(Message message) {\n}\n",
+	}
+	other := map[string]string{
+		".git/HEAD":  "ref: refs/heads/main\n",
+		".gitignore": "*.zip\n",
+		"README.md":  "# PurchaseOrder\n",
+		"src/main/resources/script/.script1.groovy.swp": "swap",
+	}
+	for _, m := range []map[string]string{files, other} {
+		for name, content := range m {
+			filePath := filepath.Join(dir, filepath.FromSlash(name))
+			os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+			os.WriteFile(filePath, []byte(content), 0666)
+		}
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var method string
+			var body map[string]string
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					switch r.Method {
+					case "GET":
+						if r.Header.Get("X-CSRF-Token") == "Fetch" {
+							w.WriteHeader(http.StatusOK)
+							return
+						}
+						if !tc.exists {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						fmt.Fprint(w, `{"d": {"Id": "PurchaseOrder", "Name": "Purchase Order", "Version": "1.0.0"}}`)
+					default:
+						method = r.Method
+						json.NewDecoder(r.Body).Decode(&body)
+						w.WriteHeader(http.StatusCreated)
+						fmt.Fprint(w, `{"d": {"Id": "PurchaseOrder", "Name": "Purchase Order", "Version": "1.0.0"}}`)
+					}
+				})
+			defer cleanup()
+
+			conf := getTestConfiguration()
+			conf.ApiURL = url
+
+			var out bytes.Buffer
+			err := client.PushFlow(&out, conf, dir, tc.packageID)
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+
+			if method != tc.expMethod {
+				t.Errorf("Expected method %s, got %s", tc.expMethod, method)
+			}
+			if body["Name"] != "Purchase Order" || body["PackageId"] != tc.expPackageID {
+				t.Errorf("Unexpected request body %v", body)
+			}
+
+			content, err := base64.StdEncoding.DecodeString(body["ArtifactContent"])
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			zipReader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			missing := map[string]string{}
+			for name, content := range files {
+				missing[name] = content
+			}
+			for _, f := range zipReader.File {
+				if _, ok := other[f.Name]; ok || strings.HasPrefix(f.Name, ".git/") {
+					t.Errorf("File %s should not be part of the archive", f.Name)
+				}
+				exp, ok := missing[f.Name]
+				if !ok {
+					continue
+				}
+				rc, _ := f.Open()
+				got, _ := io.ReadAll(rc)
+				rc.Close()
+				if string(got) != exp {
+					t.Errorf("Unexpected content of %s: %q", f.Name, got)
+				}
+				delete(missing, f.Name)
+			}
+			if len(missing) != 0 {
+				t.Errorf("Files missing in the archive: %v", missing)
+			}
+		})
+	}
+}
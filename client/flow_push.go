@@ -0,0 +1,100 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/iflow"
+)
+
+//RunPushFlow - call the function PushFlow
+func RunPushFlow(out io.Writer, conf config.Configuration, dir string, packageID string) {
+	err := PushFlow(out, conf, dir, packageID)
+	if err != nil {
+		log.Fatal("Error in PushFlow: ", err)
+	}
+}
+
+//PushFlow zips the files of the flow in the directory and updates the integration flow with the id from Bundle-SymbolicName
+//of the manifest. The flow is created in the package when it does not exist. The archive contains the same files as
+//pulled by flow pull, other files of the directory like .git or README.md are skipped.
+func PushFlow(out io.Writer, conf config.Configuration, dir string, packageID string) error {
+	if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(iflow.ManifestPath))); err != nil {
+		return fmt.Errorf("%w: directory %s does not contain integration flow: %s", ErrInvalid, dir, err)
+	}
+	flow, err := readFlowSource(dir)
+	if err != nil {
+		return err
+	}
+	flowID := flow.ID()
+	flowName := flow.Name()
+	if flowID == "" {
+		return fmt.Errorf("%w: Bundle-SymbolicName not set in %s", ErrInvalid, iflow.ManifestPath)
+	}
+	if flowName == "" {
+		flowName = flowID
+	}
+
+	flowExists := true
+	_, err = InspectArtifact(conf, FlowArtifact, flowID, "active")
+	if errors.Is(err, ErrNotFound) {
+		flowExists = false
+	} else if err != nil {
+		return err
+	}
+	if !flowExists && packageID == "" {
+		return fmt.Errorf("%w: integration flow %s does not exist, package id is required to create it", ErrInvalid, flowID)
+	}
+
+	content, err := flow.Bytes()
+	if err != nil {
+		return fmt.Errorf("error creating zip file: %w", err)
+	}
+
+	if flowExists {
+		return UpdateArtifact(out, conf, FlowArtifact, flowName, flowID, "active", bytes.NewReader(content))
+	}
+
+	createResp, err := CreateArtifact(conf, FlowArtifact, flowName, flowID, packageID, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	createResp.Print(out)
+	return nil
+}
+
+//readFlowSource reads the flow from the zip file or from the directory created by flow pull
+func readFlowSource(sourcePath string) (*iflow.Flow, error) {
+	info, err := os.Stat(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return iflow.ReadFile(sourcePath)
+	}
+
+	var files []*iflow.File
+	err = walkFlowDir(sourcePath, func(name string, path string, d fs.DirEntry) error {
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files = append(files, &iflow.File{Name: name, Modified: info.ModTime(), Content: content})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return iflow.New(files)
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func idempotentRepositoryEntryURL(conf config.Configuration, source string, entry string) string {
+	return conf.ApiURL + "/IdempotentRepositoryEntries(HexSource=" + odataKey(hexKey(source)) +
+		",HexEntry=" + odataKey(hexKey(entry)) + ")"
+}
+
+//RunGetIdempotentRepositoryEntries - call the function GetIdempotentRepositoryEntries
+func RunGetIdempotentRepositoryEntries(out io.Writer, conf config.Configuration, source string, entry string) {
+	resp, err := GetIdempotentRepositoryEntries(conf, source, entry)
+	if err != nil {
+		log.Fatal("Error in GetIdempotentRepositoryEntries:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetIdempotentRepositoryEntries - search the idempotent repository entries by source and entry id, empty values are not filtered
+func GetIdempotentRepositoryEntries(conf config.Configuration, source string, entry string) (*model.IdempotentRepositoryEntriesResponse, error) {
+	entriesURL := conf.ApiURL + "/IdempotentRepositoryEntries"
+
+	var conditions []string
+	if source != "" {
+		conditions = append(conditions, "Source eq "+odataKey(source))
+	}
+	if entry != "" {
+		conditions = append(conditions, "Entry eq "+odataKey(entry))
+	}
+	if len(conditions) > 0 {
+		entriesURL += "?" + odataFilter(strings.Join(conditions, " and "))
+	}
+
+	var decodedRes model.IdempotentRepositoryEntriesResponse
+	if err := getEntity(conf, entriesURL, &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunDeleteIdempotentRepositoryEntry - call the function DeleteIdempotentRepositoryEntry
+func RunDeleteIdempotentRepositoryEntry(out io.Writer, conf config.Configuration, source string, entry string) {
+	err := DeleteIdempotentRepositoryEntry(out, conf, source, entry)
+	if err != nil {
+		log.Fatal("Error in DeleteIdempotentRepositoryEntry:\n", err)
+	}
+}
+
+//DeleteIdempotentRepositoryEntry - delete the idempotent repository entry, so that the message can be processed again
+func DeleteIdempotentRepositoryEntry(out io.Writer, conf config.Configuration, source string, entry string) error {
+	if err := sendEntity(conf, "DELETE", idempotentRepositoryEntryURL(conf, source, entry), nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Idempotent repository entry: %s/%s deleted\n", source, entry)
+	return nil
+}
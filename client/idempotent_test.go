@@ -0,0 +1,75 @@
+package client_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestGetIdempotentRepositoryEntries(t *testing.T) {
+	testCases := []struct {
+		name      string
+		source    string
+		entry     string
+		expFilter string
+	}{
+		{name: "all"},
+		{name: "source", source: "/inbound/orders", expFilter: "Source eq '/inbound/orders'"},
+		{name: "sourceAndEntry", source: "/inbound/orders", entry: "order's.xml", expFilter: "Source eq '/inbound/orders' and Entry eq 'order''s.xml'"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var filter string
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					filter = r.URL.Query().Get("$filter")
+					w.WriteHeader(http.StatusOK)
+					fmt.Fprintln(w, `{"d": {"results": [{"Source": "/inbound/orders", "Entry": "order.xml", "Component": "SFTP", "CreationTime": "/Date(1665000000000)/"}]}}`)
+				})
+			defer cleanup()
+
+			conf := getTestConfiguration()
+			conf.ApiURL = url
+
+			resp, err := client.GetIdempotentRepositoryEntries(conf, tc.source, tc.entry)
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if filter != tc.expFilter {
+				t.Errorf("Expected filter %q, got %q", tc.expFilter, filter)
+			}
+			if len(resp.D.Results) != 1 {
+				t.Errorf("Expected 1 entry, got %d", len(resp.D.Results))
+			}
+		})
+	}
+}
+
+func TestDeleteIdempotentRepositoryEntry(t *testing.T) {
+	var requestPath string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "DELETE" {
+				requestPath = r.URL.Path
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	var out bytes.Buffer
+	err := client.DeleteIdempotentRepositoryEntry(&out, conf, "SFTP", "a.xml")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	expPath := "/IdempotentRepositoryEntries(HexSource='53465450',HexEntry='612e786d6c')"
+	if requestPath != expPath {
+		t.Errorf("Expected request to %s, got %s", expPath, requestPath)
+	}
+}
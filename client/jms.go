@@ -0,0 +1,104 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func jmsMessageURL(conf config.Configuration, queue string, msgID string) string {
+	return conf.ApiURL + "/JmsMessages(Msgid=" + odataKey(msgID) + ",Name=" + odataKey(queue) + ")"
+}
+
+//RunGetQueues - print the JMS queues, exit with error when the capacity usage of any queue reaches the threshold
+func RunGetQueues(out io.Writer, conf config.Configuration, threshold float64) {
+	resp, err := GetQueues(conf)
+	if err != nil {
+		log.Fatal("Error in GetQueues:\n", err)
+	}
+	resp.Print(out)
+
+	if threshold <= 0 {
+		return
+	}
+	exceeded := QueuesAboveThreshold(resp, threshold)
+	if len(exceeded) > 0 {
+		log.Fatal(fmt.Errorf("%w: capacity usage of %d queues is at least %.0f%%: %v", ErrThreshold, len(exceeded), threshold, exceeded))
+	}
+}
+
+//GetQueues - get list of the JMS queues
+func GetQueues(conf config.Configuration) (*model.QueuesResponse, error) {
+	var decodedRes model.QueuesResponse
+	if err := getEntity(conf, conf.ApiURL+"/Queues", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//QueuesAboveThreshold returns names of the queues with capacity usage at least threshold percent
+func QueuesAboveThreshold(resp *model.QueuesResponse, threshold float64) []string {
+	var names []string
+	for _, q := range resp.D.Results {
+		if q.Usage() >= threshold {
+			names = append(names, q.Name)
+		}
+	}
+	return names
+}
+
+//RunGetJmsMessages - call the function GetJmsMessages
+func RunGetJmsMessages(out io.Writer, conf config.Configuration, queue string) {
+	resp, err := GetJmsMessages(conf, queue)
+	if err != nil {
+		log.Fatal("Error in GetJmsMessages:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetJmsMessages - get list of the messages of the JMS queue
+func GetJmsMessages(conf config.Configuration, queue string) (*model.JmsMessagesResponse, error) {
+	var decodedRes model.JmsMessagesResponse
+	if err := getEntity(conf, conf.ApiURL+"/Queues("+odataKey(queue)+")/Messages", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunRetryJmsMessage - call the function RetryJmsMessage
+func RunRetryJmsMessage(out io.Writer, conf config.Configuration, queue string, msgID string) {
+	err := RetryJmsMessage(out, conf, queue, msgID)
+	if err != nil {
+		log.Fatal("Error in RetryJmsMessage:\n", err)
+	}
+}
+
+//RetryJmsMessage - schedule immediate retry of the message of the JMS queue
+func RetryJmsMessage(out io.Writer, conf config.Configuration, queue string, msgID string) error {
+	retryURL := conf.ApiURL + "/RetryJmsMessages?Msgid=" + odataKey(msgID) + "&Name=" + odataKey(queue)
+	if err := sendEntity(conf, "POST", retryURL, nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "JMS message: %s/%s retried\n", queue, msgID)
+	return nil
+}
+
+//RunDeleteJmsMessage - call the function DeleteJmsMessage
+func RunDeleteJmsMessage(out io.Writer, conf config.Configuration, queue string, msgID string) {
+	err := DeleteJmsMessage(out, conf, queue, msgID)
+	if err != nil {
+		log.Fatal("Error in DeleteJmsMessage:\n", err)
+	}
+}
+
+//DeleteJmsMessage - delete the message from the JMS queue
+func DeleteJmsMessage(out io.Writer, conf config.Configuration, queue string, msgID string) error {
+	if err := sendEntity(conf, "DELETE", jmsMessageURL(conf, queue, msgID), nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "JMS message: %s/%s deleted\n", queue, msgID)
+	return nil
+}
@@ -0,0 +1,83 @@
+package client_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestQueuesAboveThreshold(t *testing.T) {
+	body := `{"d": {"results": [
+		{"Name": "JMS_Orders", "Active": true, "State": 0, "NumbOfMsgs": "10", "Size": "1000", "MaxNumbOfMsgs": "100000", "MaxSize": "100000"},
+		{"Name": "JMS_Invoices", "Active": true, "State": 1, "NumbOfMsgs": 85000, "Size": 2000, "MaxNumbOfMsgs": 100000, "MaxSize": 100000},
+		{"Name": "JMS_Errors", "Active": false, "State": 0, "NumbOfMsgs": "1", "Size": "95000", "MaxNumbOfMsgs": "100000", "MaxSize": "100000"}
+	]}}`
+
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, body)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	resp, err := client.GetQueues(conf)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	testCases := []struct {
+		name      string
+		threshold float64
+		exp       []string
+	}{
+		{name: "none", threshold: 99},
+		{name: "size", threshold: 90, exp: []string{"JMS_Errors"}},
+		{name: "count", threshold: 80, exp: []string{"JMS_Invoices", "JMS_Errors"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			names := client.QueuesAboveThreshold(resp, tc.threshold)
+			if !reflect.DeepEqual(names, tc.exp) {
+				t.Errorf("Expected %v, got %v", tc.exp, names)
+			}
+		})
+	}
+}
+
+func TestDeleteJmsMessage(t *testing.T) {
+	var requestPath string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "DELETE" {
+				requestPath = r.URL.Path
+			}
+			w.Header().Set("X-CSRF-Token", "token")
+			w.WriteHeader(http.StatusOK)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	var out bytes.Buffer
+	err := client.DeleteJmsMessage(&out, conf, "JMS_Orders", "ID:4711")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	expPath := "/JmsMessages(Msgid='ID:4711',Name='JMS_Orders')"
+	if requestPath != expPath {
+		t.Errorf("Expected request to %s, got %s", expPath, requestPath)
+	}
+	expOut := "JMS message: JMS_Orders/ID:4711 deleted\n"
+	if out.String() != expOut {
+		t.Errorf("Expected output %q, got %q", expOut, out.String())
+	}
+}
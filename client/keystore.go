@@ -0,0 +1,199 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//RunGetKeystoreEntries - call the function GetKeystoreEntries
+func RunGetKeystoreEntries(out io.Writer, conf config.Configuration) {
+	resp, err := GetKeystoreEntries(conf)
+	if err != nil {
+		log.Fatal("Error in GetKeystoreEntries:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetKeystoreEntries - get list of the entries of the tenant keystore
+func GetKeystoreEntries(conf config.Configuration) (*model.KeystoreEntriesResponse, error) {
+	var decodedRes model.KeystoreEntriesResponse
+	if err := getEntity(conf, conf.ApiURL+"/KeystoreEntries", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunGetExpiringKeystoreEntries - print the entries expiring within the days, exit with error if there are any
+func RunGetExpiringKeystoreEntries(out io.Writer, conf config.Configuration, days int) {
+	resp, err := GetExpiringKeystoreEntries(conf, days, time.Now())
+	if err != nil {
+		log.Fatal("Error in GetExpiringKeystoreEntries:\n", err)
+	}
+	if len(resp.D.Results) == 0 {
+		fmt.Fprintf(out, "No keystore entries expire within %d days\n", days)
+		return
+	}
+	resp.Print(out)
+	log.Fatal(fmt.Errorf("%w: %d keystore entries expire within %d days", ErrThreshold, len(resp.D.Results), days))
+}
+
+//GetExpiringKeystoreEntries - get the keystore entries which expire before now + days, sorted by the expiry date
+func GetExpiringKeystoreEntries(conf config.Configuration, days int, now time.Time) (*model.KeystoreEntriesResponse, error) {
+	resp, err := GetKeystoreEntries(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := now.AddDate(0, 0, days)
+	var expiring model.KeystoreEntriesResponse
+	for _, e := range resp.D.Results {
+		if e.ValidNotAfter.IsZero() {
+			continue
+		}
+		if e.ValidNotAfter.Before(limit) {
+			expiring.D.Results = append(expiring.D.Results, e)
+		}
+	}
+	sort.Slice(expiring.D.Results, func(i, j int) bool {
+		return expiring.D.Results[i].ValidNotAfter.Before(expiring.D.Results[j].ValidNotAfter.Time)
+	})
+
+	return &expiring, nil
+}
+
+//RunDownloadKeystoreCertificate - call the function DownloadKeystoreCertificate
+func RunDownloadKeystoreCertificate(out io.Writer, conf config.Configuration, alias string, outputFile string) {
+	if outputFile == "" {
+		outputFile = alias + ".cer"
+	}
+
+	outputContent, err := os.OpenFile(outputFile, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		log.Fatal("Error Openning file:\n", err)
+	}
+	defer outputContent.Close()
+
+	err = DownloadKeystoreCertificate(out, conf, alias, outputContent)
+	if err != nil {
+		log.Fatal("Error in DownloadKeystoreCertificate: ", err)
+	}
+}
+
+//DownloadKeystoreCertificate - download the certificate of the keystore entry
+func DownloadKeystoreCertificate(out io.Writer, conf config.Configuration, alias string, outputContent io.Writer) error {
+	certificateURL := conf.ApiURL + "/KeystoreEntries(" + odataKey(hexKey(alias)) + ")/Certificate/$value"
+	content, err := getEntityContent(conf, certificateURL, "")
+	if err != nil {
+		return err
+	}
+
+	n, err := saveBodyContent(outputContent, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Certificate of %s downloaded.\nnumber of bytes: %d\n", alias, n)
+	return nil
+}
+
+//RunUploadKeystoreCertificate - call the function UploadKeystoreCertificate
+func RunUploadKeystoreCertificate(out io.Writer, conf config.Configuration, alias string, fileName string, overwrite bool) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		log.Fatal("Error reading file:\n", err)
+	}
+
+	err = UploadKeystoreCertificate(out, conf, alias, content, overwrite)
+	if err != nil {
+		log.Fatal("Error in UploadKeystoreCertificate:\n", err)
+	}
+}
+
+//UploadKeystoreCertificate - add the certificate (PEM or DER) to the keystore, the existing entry is replaced only when overwrite is set
+func UploadKeystoreCertificate(out io.Writer, conf config.Configuration, alias string, content []byte, overwrite bool) error {
+	exists, err := keystoreEntryExists(conf, alias)
+	if err != nil {
+		return err
+	}
+	if exists && !overwrite {
+		return fmt.Errorf("%w: keystore entry %s already exists, use --overwrite to replace it", ErrInvalid, alias)
+	}
+
+	certificateURL := conf.ApiURL + "/CertificateResources(" + odataKey(hexKey(alias)) + ")/$value" +
+		"?fingerprintVerified=true&returnKeystoreEntries=false&update=" + strconv.FormatBool(exists)
+	err = sendEntityContent(conf, "PUT", certificateURL, "application/octet-stream", bytes.NewReader(content), nil)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Certificate: %s uploaded\n", alias)
+	return nil
+}
+
+//RunUploadKeystoreKeyPair - call the function UploadKeystoreKeyPair
+func RunUploadKeystoreKeyPair(out io.Writer, conf config.Configuration, alias string, fileName string, password string, overwrite bool) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		log.Fatal("Error reading file:\n", err)
+	}
+
+	err = UploadKeystoreKeyPair(out, conf, alias, content, password, overwrite)
+	if err != nil {
+		log.Fatal("Error in UploadKeystoreKeyPair:\n", err)
+	}
+}
+
+//UploadKeystoreKeyPair - add the key pair (PKCS#12 file) to the keystore, the existing entry is replaced only when overwrite is set.
+//The password is sent in the request body, so it does not appear in the logged URL.
+func UploadKeystoreKeyPair(out io.Writer, conf config.Configuration, alias string, content []byte, password string, overwrite bool) error {
+	exists, err := keystoreEntryExists(conf, alias)
+	if err != nil {
+		return err
+	}
+	if exists && !overwrite {
+		return fmt.Errorf("%w: keystore entry %s already exists, use --overwrite to replace it", ErrInvalid, alias)
+	}
+
+	requestBody := map[string]string{
+		"Hexalias": hexKey(alias),
+		"Alias":    alias,
+		"Resource": base64.StdEncoding.EncodeToString(content),
+		"Password": password,
+	}
+
+	keyPairURL := conf.ApiURL + "/KeyPairResources"
+	method := "POST"
+	if exists {
+		keyPairURL += "(" + odataKey(hexKey(alias)) + ")"
+		method = "PUT"
+	}
+	if err := sendEntity(conf, method, keyPairURL, requestBody, nil); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Key pair: %s uploaded\n", alias)
+	return nil
+}
+
+func keystoreEntryExists(conf config.Configuration, alias string) (bool, error) {
+	var decodedRes model.KeystoreEntryByIdResponse
+	err := getEntity(conf, conf.ApiURL+"/KeystoreEntries("+odataKey(hexKey(alias))+")", &decodedRes)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,109 @@
+package client_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestGetExpiringKeystoreEntries(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	in10Days := now.AddDate(0, 0, 10).UnixMilli()
+	in5Days := now.AddDate(0, 0, 5).UnixMilli()
+	in100Days := now.AddDate(0, 0, 100).UnixMilli()
+	body := fmt.Sprintf(`{"d": {"results": [
+		{"Hexalias": "73617030", "Alias": "sap0", "Type": "Certificate", "ValidNotAfter": "/Date(%d)/"},
+		{"Hexalias": "73617031", "Alias": "sap1", "Type": "KeyPair", "ValidNotAfter": "/Date(%d)/"},
+		{"Hexalias": "73617032", "Alias": "sap2", "Type": "Certificate", "ValidNotAfter": "/Date(%d)/"},
+		{"Hexalias": "73617033", "Alias": "sap3", "Type": "SSHKey", "ValidNotAfter": null}
+	]}}`, in10Days, in100Days, in5Days)
+
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, body)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	resp, err := client.GetExpiringKeystoreEntries(conf, 30, now)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	var aliases []string
+	for _, e := range resp.D.Results {
+		aliases = append(aliases, e.Alias)
+	}
+	if strings.Join(aliases, ",") != "sap2,sap0" {
+		t.Errorf("Expected expiring entries sap2,sap0, got %v", aliases)
+	}
+
+	var out bytes.Buffer
+	resp.Print(&out)
+	if !strings.Contains(out.String(), "2023-01-06 00:00:00") {
+		t.Errorf("Expected expiry date in output, got:\n%s", out.String())
+	}
+}
+
+func TestUploadKeystoreCertificate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		exists    bool
+		overwrite bool
+		expError  error
+		expQuery  string
+	}{
+		{name: "new", expQuery: "update=false"},
+		{name: "existsNoOverwrite", exists: true, expError: client.ErrInvalid},
+		{name: "overwrite", exists: true, overwrite: true, expQuery: "update=true"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var putURL string
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					switch {
+					case r.Method == "PUT":
+						putURL = r.URL.String()
+						w.WriteHeader(http.StatusOK)
+					case strings.HasPrefix(r.URL.Path, "/KeystoreEntries(") && !tc.exists:
+						w.WriteHeader(http.StatusNotFound)
+					default:
+						w.WriteHeader(http.StatusOK)
+						fmt.Fprintln(w, `{"d": {"Hexalias": "6d795f63657274", "Alias": "my_cert"}}`)
+					}
+				})
+			defer cleanup()
+
+			conf := getTestConfiguration()
+			conf.ApiURL = url
+
+			var out bytes.Buffer
+			err := client.UploadKeystoreCertificate(&out, conf, "my_cert", []byte("-----BEGIN CERTIFICATE-----"), tc.overwrite)
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				if putURL != "" {
+					t.Errorf("Expected no upload, got %s", putURL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if !strings.Contains(putURL, "/CertificateResources('6d795f63657274')/$value") || !strings.Contains(putURL, tc.expQuery) {
+				t.Errorf("Unexpected upload URL %s", putURL)
+			}
+		})
+	}
+}
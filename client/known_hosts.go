@@ -0,0 +1,160 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//knownHostsURL is the file resource with the SSH known_hosts of the tenant used by the SFTP adapter
+func knownHostsURL(conf config.Configuration) string {
+	return conf.ApiURL + "/FileResources('known.hosts')/$value"
+}
+
+//RunDownloadKnownHosts - call the function DownloadKnownHosts
+func RunDownloadKnownHosts(out io.Writer, conf config.Configuration, outputFile string) {
+	if outputFile == "" {
+		err := DownloadKnownHosts(io.Discard, conf, out)
+		if err != nil {
+			log.Fatal("Error in DownloadKnownHosts: ", err)
+		}
+		return
+	}
+
+	outputContent, err := os.OpenFile(outputFile, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		log.Fatal("Error Openning file:\n", err)
+	}
+	defer outputContent.Close()
+
+	err = DownloadKnownHosts(out, conf, outputContent)
+	if err != nil {
+		log.Fatal("Error in DownloadKnownHosts: ", err)
+	}
+}
+
+//DownloadKnownHosts - download the known_hosts file of the tenant
+func DownloadKnownHosts(out io.Writer, conf config.Configuration, outputContent io.Writer) error {
+	content, err := getEntityContent(conf, knownHostsURL(conf), "")
+	if err != nil {
+		return err
+	}
+
+	n, err := saveBodyContent(outputContent, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Known hosts downloaded.\nnumber of bytes: %d\n", n)
+	return nil
+}
+
+//RunUploadKnownHosts - call the function UploadKnownHosts
+func RunUploadKnownHosts(out io.Writer, conf config.Configuration, fileName string) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		log.Fatal("Error reading file:\n", err)
+	}
+
+	err = UploadKnownHosts(out, conf, content)
+	if err != nil {
+		log.Fatal("Error in UploadKnownHosts:\n", err)
+	}
+}
+
+//UploadKnownHosts - replace the known_hosts file of the tenant
+func UploadKnownHosts(out io.Writer, conf config.Configuration, content []byte) error {
+	err := sendEntityContent(conf, "PUT", knownHostsURL(conf), "text/plain", bytes.NewReader(content), nil)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Known hosts uploaded.\nnumber of bytes: %d\n", len(content))
+	return nil
+}
+
+//RunAppendKnownHosts - call the function AppendKnownHosts, the entries are read from the file or from stdin
+func RunAppendKnownHosts(out io.Writer, conf config.Configuration, fileName string) {
+	var entries io.Reader = os.Stdin
+	if fileName != "" {
+		f, err := os.Open(fileName)
+		if err != nil {
+			log.Fatal("Error Openning file:\n", err)
+		}
+		defer f.Close()
+		entries = f
+	}
+
+	err := AppendKnownHosts(out, conf, entries)
+	if err != nil {
+		log.Fatal("Error in AppendKnownHosts:\n", err)
+	}
+}
+
+//AppendKnownHosts - add entries to the known_hosts file of the tenant, entries which already exist are skipped
+func AppendKnownHosts(out io.Writer, conf config.Configuration, entries io.Reader) error {
+	current, err := getEntityContent(conf, knownHostsURL(conf), "")
+	if errors.Is(err, ErrNotFound) {
+		current, err = nil, nil
+	}
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	for _, line := range strings.Split(string(current), "\n") {
+		existing[strings.TrimSpace(line)] = true
+	}
+
+	var added []string
+	scanner := bufio.NewScanner(entries)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || existing[line] {
+			continue
+		}
+		existing[line] = true
+		added = append(added, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if len(added) == 0 {
+		fmt.Fprintln(out, "Known hosts are up to date")
+		return nil
+	}
+
+	content := current
+	if len(content) > 0 && !bytes.HasSuffix(content, []byte("\n")) {
+		content = append(content, '\n')
+	}
+	content = append(content, []byte(strings.Join(added, "\n")+"\n")...)
+
+	err = sendEntityContent(conf, "PUT", knownHostsURL(conf), "text/plain", bytes.NewReader(content), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range added {
+		fmt.Fprintf(out, "+ %s\n", knownHostsEntryHost(line))
+	}
+	fmt.Fprintf(out, "added: %d\n", len(added))
+	return nil
+}
+
+//knownHostsEntryHost returns host and key type of the known_hosts line
+func knownHostsEntryHost(line string) string {
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return line
+	}
+	return fields[0] + " " + fields[1]
+}
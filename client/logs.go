@@ -0,0 +1,154 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//LogFileTypes - types of the log files which can be downloaded as archive
+var LogFileTypes = []string{"http", "trace"}
+
+//ParseSince parses the duration (e.g. 24h) or the date (2006-01-02, RFC3339) and returns the point in time
+func ParseSince(since string, now time.Time) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(since); err == nil {
+		return now.Add(-d), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, since, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: since %s, expected duration (e.g. 24h) or date (e.g. 2006-01-02)", ErrInvalid, since)
+}
+
+//RunGetLogFiles - call the function GetLogFiles
+func RunGetLogFiles(out io.Writer, conf config.Configuration) {
+	resp, err := GetLogFiles(conf)
+	if err != nil {
+		log.Fatal("Error in GetLogFiles:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetLogFiles - get list of the system log files
+func GetLogFiles(conf config.Configuration) (*model.LogFilesResponse, error) {
+	var decodedRes model.LogFilesResponse
+	if err := getEntity(conf, conf.ApiURL+"/LogFiles", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//SelectLogFiles returns the log files with the name or the type, modified at or after since when it is not zero
+func SelectLogFiles(files []model.LogFile, name string, logFileType string, since time.Time) []model.LogFile {
+	var selected []model.LogFile
+	for _, f := range files {
+		if name != "" && f.Name != name {
+			continue
+		}
+		if logFileType != "" && !strings.EqualFold(f.LogFileType, logFileType) {
+			continue
+		}
+		if !since.IsZero() && f.LastModified.Before(since) {
+			continue
+		}
+		selected = append(selected, f)
+	}
+	return selected
+}
+
+//RunDownloadLogFiles - download the log file with the name, or the archive of all log files of the type.
+//With since only log files of the type modified after since are downloaded.
+func RunDownloadLogFiles(out io.Writer, conf config.Configuration, name string, logFileType string, since string, dir string) {
+	if name == "" && logFileType == "" {
+		log.Fatal("Required flag name or type not set")
+	}
+	if logFileType != "" && !containsString(LogFileTypes, strings.ToLower(logFileType)) {
+		log.Fatalf("%v: type %s, available values: %s", ErrInvalid, logFileType, strings.Join(LogFileTypes, ", "))
+	}
+	sinceTime, err := ParseSince(since, time.Now())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		log.Fatal("Error creating directory:\n", err)
+	}
+
+	if name == "" && sinceTime.IsZero() {
+		err = DownloadLogFileArchive(out, conf, strings.ToLower(logFileType), dir)
+		if err != nil {
+			log.Fatal("Error in DownloadLogFileArchive:\n", err)
+		}
+		return
+	}
+
+	files, err := GetLogFiles(conf)
+	if err != nil {
+		log.Fatal("Error in GetLogFiles:\n", err)
+	}
+	selected := SelectLogFiles(files.D.Results, name, logFileType, sinceTime)
+	if len(selected) == 0 {
+		log.Fatal(fmt.Errorf("%w: no log files selected", ErrNotFound))
+	}
+	for _, f := range selected {
+		err = DownloadLogFile(out, conf, f, dir)
+		if err != nil {
+			log.Fatal("Error in DownloadLogFile:\n", err)
+		}
+	}
+}
+
+//DownloadLogFile - download the log file to the directory
+func DownloadLogFile(out io.Writer, conf config.Configuration, file model.LogFile, dir string) error {
+	fileURL := conf.ApiURL + "/LogFiles(Name=" + odataKey(file.Name) + ",Application=" + odataKey(file.Application) + ")/$value"
+	return downloadLogContent(out, conf, fileURL, filepath.Join(dir, filepath.Base(file.Name)))
+}
+
+//DownloadLogFileArchive - download the zip archive of all log files of the type to the directory
+func DownloadLogFileArchive(out io.Writer, conf config.Configuration, logFileType string, dir string) error {
+	archiveURL := conf.ApiURL + "/LogFileArchives(Scope='all',LogFileType=" + odataKey(logFileType) + ",NodeScope='worker')/$value"
+	return downloadLogContent(out, conf, archiveURL, filepath.Join(dir, logFileType+"_logs.zip"))
+}
+
+func downloadLogContent(out io.Writer, conf config.Configuration, contentURL string, fileName string) error {
+	content, err := getEntityContent(conf, contentURL, "")
+	if err != nil {
+		return err
+	}
+
+	outputContent, err := os.OpenFile(fileName, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+	defer outputContent.Close()
+
+	n, err := saveBodyContent(outputContent, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "%s downloaded, number of bytes: %d\n", fileName, n)
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package client_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2022, 10, 15, 12, 0, 0, 0, time.Local)
+
+	testCases := []struct {
+		name     string
+		since    string
+		exp      time.Time
+		expError error
+	}{
+		{name: "empty"},
+		{name: "duration", since: "24h", exp: time.Date(2022, 10, 14, 12, 0, 0, 0, time.Local)},
+		{name: "date", since: "2022-10-01", exp: time.Date(2022, 10, 1, 0, 0, 0, 0, time.Local)},
+		{name: "invalid", since: "yesterday", expError: client.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			since, err := client.ParseSince(tc.since, now)
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if !since.Equal(tc.exp) {
+				t.Errorf("Expected %s, got %s", tc.exp, since)
+			}
+		})
+	}
+}
+
+func TestSelectLogFiles(t *testing.T) {
+	modified := func(day int) model.ODataTime {
+		return model.ODataTime{Time: time.Date(2022, 10, day, 0, 0, 0, 0, time.UTC)}
+	}
+	files := []model.LogFile{
+		{Name: "http_access_1.log", LogFileType: "http", LastModified: modified(1)},
+		{Name: "http_access_2.log", LogFileType: "http", LastModified: modified(10)},
+		{Name: "ljs_trace_1.log", LogFileType: "trace", LastModified: modified(10)},
+	}
+
+	testCases := []struct {
+		name        string
+		fileName    string
+		logFileType string
+		since       time.Time
+		exp         int
+	}{
+		{name: "name", fileName: "ljs_trace_1.log", exp: 1},
+		{name: "type", logFileType: "HTTP", exp: 2},
+		{name: "typeSince", logFileType: "http", since: modified(5).Time, exp: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selected := client.SelectLogFiles(files, tc.fileName, tc.logFileType, tc.since)
+			if len(selected) != tc.exp {
+				t.Errorf("Expected %d log files, got %d", tc.exp, len(selected))
+			}
+		})
+	}
+}
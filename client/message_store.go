@@ -0,0 +1,98 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//RunGetMessageStoreEntries - call the function GetMessageStoreEntries
+func RunGetMessageStoreEntries(out io.Writer, conf config.Configuration, messageGuid string) {
+	resp, err := GetMessageStoreEntries(conf, messageGuid)
+	if err != nil {
+		log.Fatal("Error in GetMessageStoreEntries:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetMessageStoreEntries - get list of the message store entries of the message processing log
+func GetMessageStoreEntries(conf config.Configuration, messageGuid string) (*model.MessageStoreEntriesResponse, error) {
+	var decodedRes model.MessageStoreEntriesResponse
+	if err := getEntity(conf, conf.ApiURL+"/MessageProcessingLogs("+odataKey(messageGuid)+")/MessageStoreEntries", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunGetMessageStoreEntryAttachments - call the function GetMessageStoreEntryAttachments
+func RunGetMessageStoreEntryAttachments(out io.Writer, conf config.Configuration, entryID string) {
+	resp, err := GetMessageStoreEntryAttachments(conf, entryID)
+	if err != nil {
+		log.Fatal("Error in GetMessageStoreEntryAttachments:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetMessageStoreEntryAttachments - get list of the attachments of the message store entry
+func GetMessageStoreEntryAttachments(conf config.Configuration, entryID string) (*model.MessageStoreEntryAttachmentsResponse, error) {
+	var decodedRes model.MessageStoreEntryAttachmentsResponse
+	if err := getEntity(conf, conf.ApiURL+"/MessageStoreEntries("+odataKey(entryID)+")/Attachments", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunDownloadMessageStoreEntry - download the payload of the message store entry or of its attachment when attachmentID is set
+func RunDownloadMessageStoreEntry(out io.Writer, conf config.Configuration, entryID string, attachmentID string, outputFile string) {
+	if outputFile == "" {
+		outputFile = entryID
+		if attachmentID != "" {
+			outputFile = attachmentID
+		}
+	}
+
+	outputContent, err := os.OpenFile(outputFile, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		log.Fatal("Error Openning file:\n", err)
+	}
+	defer outputContent.Close()
+
+	if attachmentID != "" {
+		err = DownloadMessageStoreEntryAttachment(out, conf, attachmentID, outputContent)
+	} else {
+		err = DownloadMessageStoreEntry(out, conf, entryID, outputContent)
+	}
+	if err != nil {
+		log.Fatal("Error in DownloadMessageStoreEntry: ", err)
+	}
+}
+
+//DownloadMessageStoreEntry - download the payload of the message store entry
+func DownloadMessageStoreEntry(out io.Writer, conf config.Configuration, entryID string, outputContent io.Writer) error {
+	return downloadMessageStoreContent(out, conf, conf.ApiURL+"/MessageStoreEntries("+odataKey(entryID)+")/$value", outputContent)
+}
+
+//DownloadMessageStoreEntryAttachment - download the content of the attachment of the message store entry
+func DownloadMessageStoreEntryAttachment(out io.Writer, conf config.Configuration, attachmentID string, outputContent io.Writer) error {
+	return downloadMessageStoreContent(out, conf, conf.ApiURL+"/MessageStoreEntryAttachments("+odataKey(attachmentID)+")/$value", outputContent)
+}
+
+func downloadMessageStoreContent(out io.Writer, conf config.Configuration, contentURL string, outputContent io.Writer) error {
+	content, err := getEntityContent(conf, contentURL, "")
+	if err != nil {
+		return err
+	}
+
+	n, err := saveBodyContent(outputContent, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Content downloaded.\nnumber of bytes: %d\n", n)
+	return nil
+}
@@ -0,0 +1,142 @@
+package client_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestGetMessageStoreEntries(t *testing.T) {
+	var requestPath string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			requestPath = r.URL.Path
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, `{"d": {"results": [
+				{"Id": "sap-it-res:msg:1", "MessageGuid": "AGNhY2hl", "MessageStoreId": "orders", "TimeStamp": "/Date(1665000000000)/", "HasAttachments": true},
+				{"Id": "sap-it-res:msg:2", "MessageGuid": "AGNhY2hl", "MessageStoreId": "invoices", "TimeStamp": "/Date(1665000060000)/", "HasAttachments": false}
+			]}}`)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	resp, err := client.GetMessageStoreEntries(conf, "AGNhY2hl")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	expPath := "/MessageProcessingLogs('AGNhY2hl')/MessageStoreEntries"
+	if requestPath != expPath {
+		t.Errorf("Expected request to %s, got %s", expPath, requestPath)
+	}
+	if len(resp.D.Results) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(resp.D.Results))
+	}
+	if e := resp.D.Results[0]; e.MessageStoreId != "orders" || !e.HasAttachments || e.TimeStamp.String() == "" {
+		t.Errorf("Unexpected entry %+v", e)
+	}
+}
+
+func TestGetMessageStoreEntryAttachments(t *testing.T) {
+	var requestPath string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			requestPath = r.URL.Path
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, `{"d": {"results": [{"Id": "sap-it-res:att:1", "Name": "order.pdf", "ContentType": "application/pdf"}]}}`)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	resp, err := client.GetMessageStoreEntryAttachments(conf, "sap-it-res:msg:1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	expPath := "/MessageStoreEntries('sap-it-res:msg:1')/Attachments"
+	if requestPath != expPath {
+		t.Errorf("Expected request to %s, got %s", expPath, requestPath)
+	}
+	if len(resp.D.Results) != 1 || resp.D.Results[0].Name != "order.pdf" || resp.D.Results[0].ContentType != "application/pdf" {
+		t.Errorf("Unexpected attachments %+v", resp.D.Results)
+	}
+}
+
+func TestDownloadMessageStoreEntry(t *testing.T) {
+	contents := map[string]string{
+		"/MessageStoreEntries('sap-it-res:msg:1')/$value":          "<order/>",
+		"/MessageStoreEntryAttachments('sap-it-res:att:1')/$value": "%PDF-1.4",
+	}
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			content, ok := contents[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, content)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	testCases := []struct {
+		name       string
+		download   func(out *bytes.Buffer, content *bytes.Buffer) error
+		expContent string
+		expError   error
+	}{
+		{
+			name: "entry",
+			download: func(out *bytes.Buffer, content *bytes.Buffer) error {
+				return client.DownloadMessageStoreEntry(out, conf, "sap-it-res:msg:1", content)
+			},
+			expContent: "<order/>",
+		},
+		{
+			name: "attachment",
+			download: func(out *bytes.Buffer, content *bytes.Buffer) error {
+				return client.DownloadMessageStoreEntryAttachment(out, conf, "sap-it-res:att:1", content)
+			},
+			expContent: "%PDF-1.4",
+		},
+		{
+			name: "notFound",
+			download: func(out *bytes.Buffer, content *bytes.Buffer) error {
+				return client.DownloadMessageStoreEntry(out, conf, "sap-it-res:msg:9", content)
+			},
+			expError: client.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out, content bytes.Buffer
+			err := tc.download(&out, &content)
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if content.String() != tc.expContent {
+				t.Errorf("Expected content %q, got %q", tc.expContent, content.String())
+			}
+			expOut := fmt.Sprintf("Content downloaded.\nnumber of bytes: %d\n", len(tc.expContent))
+			if out.String() != expOut {
+				t.Errorf("Expected output %q, got %q", expOut, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+//RunDownloadTrace - call the function DownloadTrace, the default directory is the MessageGuid
+func RunDownloadTrace(out io.Writer, conf config.Configuration, messageGuid string, dir string) {
+	if dir == "" {
+		dir = messageGuid
+	}
+	err := DownloadTrace(out, conf, messageGuid, dir)
+	if err != nil {
+		log.Fatal("Error in DownloadTrace:\n", err)
+	}
+}
+
+//DownloadTrace - save the run steps of the message with payloads, headers and exchange properties of the trace messages.
+//The directory contains a folder per run and a folder per step: run1/001_CallActivity_2/{step.json,payload.xml,headers.json,properties.json}
+func DownloadTrace(out io.Writer, conf config.Configuration, messageGuid string, dir string) error {
+	var runs model.MessageProcessingLogRunsResponse
+	if err := getEntity(conf, conf.ApiURL+"/MessageProcessingLogs("+odataKey(messageGuid)+")/Runs", &runs); err != nil {
+		return err
+	}
+	if len(runs.D.Results) == 0 {
+		return fmt.Errorf("%w: message %s has no runs", ErrNotFound, messageGuid)
+	}
+
+	steps, traces := 0, 0
+	for i, run := range runs.D.Results {
+		var runSteps model.MessageProcessingLogRunStepsResponse
+		if err := getEntity(conf, conf.ApiURL+"/MessageProcessingLogRuns("+odataKey(run.Id)+")/RunSteps", &runSteps); err != nil {
+			return err
+		}
+
+		runDir := filepath.Join(dir, fmt.Sprintf("run%d", i+1))
+		for j, step := range runSteps.D.Results {
+			stepDir := filepath.Join(runDir, fmt.Sprintf("%03d_%s", j+1, unsafeFileNameChars.ReplaceAllString(step.ModelStepId, "_")))
+			if err := os.MkdirAll(stepDir, 0777); err != nil {
+				return err
+			}
+			if err := writeTraceJSON(filepath.Join(stepDir, "step.json"), step); err != nil {
+				return err
+			}
+			steps++
+
+			n, err := downloadTraceMessages(conf, step, stepDir)
+			if err != nil {
+				return err
+			}
+			traces += n
+		}
+	}
+
+	fmt.Fprintf(out, "Trace of the message: %s saved to %s, steps: %d, trace messages: %d\n", messageGuid, dir, steps, traces)
+	return nil
+}
+
+func downloadTraceMessages(conf config.Configuration, step model.MessageProcessingLogRunStep, stepDir string) (int, error) {
+	stepURL := conf.ApiURL + "/MessageProcessingLogRunSteps(RunId=" + odataKey(step.RunId) + fmt.Sprintf(",ChildCount=%d)", step.ChildCount)
+
+	var traceMessages model.TraceMessagesResponse
+	if err := getEntity(conf, stepURL+"/TraceMessages", &traceMessages); err != nil {
+		return 0, err
+	}
+
+	for i, trace := range traceMessages.D.Results {
+		prefix := ""
+		if i > 0 {
+			prefix = fmt.Sprintf("%d_", i+1)
+		}
+		traceURL := conf.ApiURL + "/TraceMessages(" + trace.TraceId.String() + "L)"
+
+		payload, err := getEntityContent(conf, traceURL+"/$value", "")
+		if err != nil {
+			return 0, err
+		}
+		if err := os.WriteFile(filepath.Join(stepDir, prefix+"payload"+traceFileExtension(trace.MimeType)), payload, 0666); err != nil {
+			return 0, err
+		}
+
+		var headers, properties model.TraceMessagePropertiesResponse
+		if err := getEntity(conf, traceURL+"/Properties", &headers); err != nil {
+			return 0, err
+		}
+		if err := writeTraceJSON(filepath.Join(stepDir, prefix+"headers.json"), headers.D.Results); err != nil {
+			return 0, err
+		}
+		if err := getEntity(conf, traceURL+"/ExchangeProperties", &properties); err != nil {
+			return 0, err
+		}
+		if err := writeTraceJSON(filepath.Join(stepDir, prefix+"properties.json"), properties.D.Results); err != nil {
+			return 0, err
+		}
+	}
+	return len(traceMessages.D.Results), nil
+}
+
+func traceFileExtension(mimeType string) string {
+	mimeType = strings.ToLower(mimeType)
+	switch {
+	case strings.Contains(mimeType, "xml"):
+		return ".xml"
+	case strings.Contains(mimeType, "json"):
+		return ".json"
+	case strings.HasPrefix(mimeType, "text/"):
+		return ".txt"
+	}
+	return ".bin"
+}
+
+func writeTraceJSON(fileName string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, append(b, '\n'), 0666)
+}
@@ -0,0 +1,67 @@
+package client_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestDownloadTrace(t *testing.T) {
+	responses := map[string]string{
+		"/MessageProcessingLogs('AGNm0ZyG')/Runs":                                `{"d": {"results": [{"Id": "RUN1", "LogLevel": "TRACE"}]}}`,
+		"/MessageProcessingLogRuns('RUN1')/RunSteps":                             `{"d": {"results": [{"RunId": "RUN1", "ChildCount": 1, "ModelStepId": "StartEvent_2"}, {"RunId": "RUN1", "ChildCount": 2, "ModelStepId": "CallActivity 3"}]}}`,
+		"/MessageProcessingLogRunSteps(RunId='RUN1',ChildCount=1)/TraceMessages": `{"d": {"results": []}}`,
+		"/MessageProcessingLogRunSteps(RunId='RUN1',ChildCount=2)/TraceMessages": `{"d": {"results": [{"TraceId": "42", "MimeType": "application/xml"}]}}`,
+		"/TraceMessages(42L)/$value":                                             `<Order/>`,
+		"/TraceMessages(42L)/Properties":                                         `{"d": {"results": [{"Name": "Content-Type", "Value": "application/xml"}]}}`,
+		"/TraceMessages(42L)/ExchangeProperties":                                 `{"d": {"results": [{"Name": "CamelSplitIndex", "Value": "0"}]}}`,
+	}
+
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, ok := responses[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, body)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	dir := t.TempDir()
+	var out bytes.Buffer
+	err := client.DownloadTrace(&out, conf, "AGNm0ZyG", dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	expFiles := []string{
+		"run1/001_StartEvent_2/step.json",
+		"run1/002_CallActivity_3/step.json",
+		"run1/002_CallActivity_3/payload.xml",
+		"run1/002_CallActivity_3/headers.json",
+		"run1/002_CallActivity_3/properties.json",
+	}
+	for _, f := range expFiles {
+		if _, err := os.Stat(filepath.Join(dir, f)); err != nil {
+			t.Errorf("Expected file %s: %q", f, err)
+		}
+	}
+	payload, _ := os.ReadFile(filepath.Join(dir, "run1/002_CallActivity_3/payload.xml"))
+	if string(payload) != "<Order/>" {
+		t.Errorf("Unexpected payload %q", payload)
+	}
+	expOut := fmt.Sprintf("Trace of the message: AGNm0ZyG saved to %s, steps: 2, trace messages: 1\n", dir)
+	if out.String() != expOut {
+		t.Errorf("Expected output %q, got %q", expOut, out.String())
+	}
+}
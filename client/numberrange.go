@@ -0,0 +1,273 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func numberRangeURL(conf config.Configuration, name string) string {
+	if name == "" {
+		return conf.ApiURL + "/NumberRanges"
+	}
+	return conf.ApiURL + "/NumberRanges(" + odataKey(name) + ")"
+}
+
+//numberRangeRequestBody - the current value is sent only when set
+func numberRangeRequestBody(n model.NumberRangeDoc) map[string]string {
+	requestBody := map[string]string{
+		"Name":        n.Name,
+		"Description": n.Description,
+		"MinValue":    n.MinValue,
+		"MaxValue":    n.MaxValue,
+		"FieldLength": n.FieldLength,
+		"Rotate":      n.Rotate,
+	}
+	if n.CurrentValue != "" {
+		requestBody["CurrentValue"] = n.CurrentValue
+	}
+	return requestBody
+}
+
+//numberRangeDoc converts the entity to the document, the current value is kept only when withValues is set
+func numberRangeDoc(n model.NumberRange, withValues bool) model.NumberRangeDoc {
+	doc := model.NumberRangeDoc{
+		Name:        n.Name,
+		Description: n.Description,
+		MinValue:    n.MinValue.String(),
+		MaxValue:    n.MaxValue.String(),
+		FieldLength: n.FieldLength.String(),
+		Rotate:      n.Rotate,
+	}
+	if withValues {
+		doc.CurrentValue = n.CurrentValue.String()
+	}
+	return doc
+}
+
+//RunGetNumberRanges - call the function GetNumberRanges
+func RunGetNumberRanges(out io.Writer, conf config.Configuration) {
+	resp, err := GetNumberRanges(conf)
+	if err != nil {
+		log.Fatal("Error in GetNumberRanges:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetNumberRanges - get list of the number range objects
+func GetNumberRanges(conf config.Configuration) (*model.NumberRangesResponse, error) {
+	var decodedRes model.NumberRangesResponse
+	if err := getEntity(conf, numberRangeURL(conf, ""), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunGetNumberRange - call the function GetNumberRange
+func RunGetNumberRange(out io.Writer, conf config.Configuration, name string) {
+	resp, err := GetNumberRange(conf, name)
+	if err != nil {
+		log.Fatal("Error in GetNumberRange:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetNumberRange - get the number range object by name
+func GetNumberRange(conf config.Configuration, name string) (*model.NumberRangeByIdResponse, error) {
+	var decodedRes model.NumberRangeByIdResponse
+	if err := getEntity(conf, numberRangeURL(conf, name), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunCreateNumberRange - call the function CreateNumberRange
+func RunCreateNumberRange(out io.Writer, conf config.Configuration, numberRange model.NumberRangeDoc) {
+	err := CreateNumberRange(out, conf, numberRange)
+	if err != nil {
+		log.Fatal("Error in CreateNumberRange:\n", err)
+	}
+}
+
+//CreateNumberRange - create new number range object
+func CreateNumberRange(out io.Writer, conf config.Configuration, numberRange model.NumberRangeDoc) error {
+	if numberRange.MinValue == "" || numberRange.MaxValue == "" {
+		return fmt.Errorf("%w: min and max value of the number range %s are required", ErrInvalid, numberRange.Name)
+	}
+	if numberRange.FieldLength == "" {
+		numberRange.FieldLength = fmt.Sprint(len(numberRange.MaxValue))
+	}
+	if numberRange.Rotate == "" {
+		numberRange.Rotate = "NO"
+	}
+
+	if err := sendEntity(conf, "POST", numberRangeURL(conf, ""), numberRangeRequestBody(numberRange), nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Number range: %s created\n", numberRange.Name)
+	return nil
+}
+
+//RunUpdateNumberRange - call the function UpdateNumberRange
+func RunUpdateNumberRange(out io.Writer, conf config.Configuration, numberRange model.NumberRangeDoc) {
+	err := UpdateNumberRange(out, conf, numberRange)
+	if err != nil {
+		log.Fatal("Error in UpdateNumberRange:\n", err)
+	}
+}
+
+//UpdateNumberRange - update the number range object, empty fields keep the current values
+func UpdateNumberRange(out io.Writer, conf config.Configuration, numberRange model.NumberRangeDoc) error {
+	current, err := GetNumberRange(conf, numberRange.Name)
+	if err != nil {
+		return err
+	}
+
+	merged := numberRangeDoc(current.D, false)
+	if numberRange.Description != "" {
+		merged.Description = numberRange.Description
+	}
+	if numberRange.MinValue != "" {
+		merged.MinValue = numberRange.MinValue
+	}
+	if numberRange.MaxValue != "" {
+		merged.MaxValue = numberRange.MaxValue
+	}
+	if numberRange.FieldLength != "" {
+		merged.FieldLength = numberRange.FieldLength
+	}
+	if numberRange.Rotate != "" {
+		merged.Rotate = numberRange.Rotate
+	}
+	merged.CurrentValue = numberRange.CurrentValue
+
+	if err := sendEntity(conf, "PUT", numberRangeURL(conf, numberRange.Name), numberRangeRequestBody(merged), nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Number range: %s updated\n", numberRange.Name)
+	return nil
+}
+
+//RunDeleteNumberRange - call the function DeleteNumberRange
+func RunDeleteNumberRange(out io.Writer, conf config.Configuration, name string) {
+	err := DeleteNumberRange(out, conf, name)
+	if err != nil {
+		log.Fatal("Error in DeleteNumberRange:\n", err)
+	}
+}
+
+//DeleteNumberRange - delete the number range object
+func DeleteNumberRange(out io.Writer, conf config.Configuration, name string) error {
+	if err := sendEntity(conf, "DELETE", numberRangeURL(conf, name), nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Number range: %s deleted\n", name)
+	return nil
+}
+
+//RunExportNumberRanges - call the function ExportNumberRanges and write the document to the file or to the output
+func RunExportNumberRanges(out io.Writer, conf config.Configuration, names []string, withValues bool, fileName string) {
+	doc, err := ExportNumberRanges(conf, names, withValues)
+	if err != nil {
+		log.Fatal("Error in ExportNumberRanges:\n", err)
+	}
+
+	content, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	content = append(content, '\n')
+
+	if fileName == "" {
+		out.Write(content)
+		return
+	}
+	if err := os.WriteFile(fileName, content, 0666); err != nil {
+		log.Fatal("Error writing file:\n", err)
+	}
+	fmt.Fprintf(out, "Number ranges: %d exported to %s\n", len(doc.NumberRanges), fileName)
+}
+
+//ExportNumberRanges - read the definitions of the number ranges, all number ranges are exported when names are empty
+func ExportNumberRanges(conf config.Configuration, names []string, withValues bool) (*model.NumberRangeDocument, error) {
+	doc := &model.NumberRangeDocument{}
+	if len(names) == 0 {
+		resp, err := GetNumberRanges(conf)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range resp.D.Results {
+			doc.NumberRanges = append(doc.NumberRanges, numberRangeDoc(n, withValues))
+		}
+		return doc, nil
+	}
+
+	for _, name := range names {
+		resp, err := GetNumberRange(conf, name)
+		if err != nil {
+			return nil, err
+		}
+		doc.NumberRanges = append(doc.NumberRanges, numberRangeDoc(resp.D, withValues))
+	}
+	return doc, nil
+}
+
+//RunImportNumberRanges - read the number range file and call the function ImportNumberRanges
+func RunImportNumberRanges(out io.Writer, conf config.Configuration, fileName string, withValues bool) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		log.Fatal("Error reading file:\n", err)
+	}
+
+	var doc model.NumberRangeDocument
+	if err := json.Unmarshal(content, &doc); err != nil {
+		log.Fatal(fmt.Errorf("%w: %s", ErrInvalid, err))
+	}
+
+	err = ImportNumberRanges(out, conf, &doc, withValues)
+	if err != nil {
+		log.Fatal("Error in ImportNumberRanges:\n", err)
+	}
+}
+
+//ImportNumberRanges - create missing and update changed number ranges from the document.
+//Current values are imported only when withValues is set and the document contains them.
+func ImportNumberRanges(out io.Writer, conf config.Configuration, doc *model.NumberRangeDocument, withValues bool) error {
+	created, updated, unchanged := 0, 0, 0
+	// the messages of the single requests are not printed, one line per number range is printed instead
+	discard := io.Discard
+
+	for _, n := range doc.NumberRanges {
+		if !withValues {
+			n.CurrentValue = ""
+		}
+		current, err := GetNumberRange(conf, n.Name)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			if err = CreateNumberRange(discard, conf, n); err == nil {
+				created++
+				fmt.Fprintf(out, "+ %s\n", n.Name)
+			}
+		case err != nil:
+		case numberRangeDoc(current.D, n.CurrentValue != "") == n:
+			unchanged++
+		default:
+			if err = UpdateNumberRange(discard, conf, n); err == nil {
+				updated++
+				fmt.Fprintf(out, "~ %s\n", n.Name)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "Number ranges imported, created: %d, updated: %d, unchanged: %d\n", created, updated, unchanged)
+	return nil
+}
@@ -0,0 +1,116 @@
+package client_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func TestImportNumberRanges(t *testing.T) {
+	existing := map[string]string{
+		"/NumberRanges('IDOC_ORDERS')":   `{"d": {"Name": "IDOC_ORDERS", "Description": "Orders", "MinValue": "1", "MaxValue": "9999", "FieldLength": "4", "Rotate": "YES", "CurrentValue": "17"}}`,
+		"/NumberRanges('IDOC_INVOICES')": `{"d": {"Name": "IDOC_INVOICES", "Description": "Invoices", "MinValue": "1", "MaxValue": "999", "FieldLength": "3", "Rotate": "NO", "CurrentValue": "5"}}`,
+	}
+
+	var requests []string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "GET" {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			if r.URL.Path == "/" {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			body, ok := existing[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, body)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	doc := &model.NumberRangeDocument{
+		NumberRanges: []model.NumberRangeDoc{
+			{Name: "IDOC_ORDERS", Description: "Orders", MinValue: "1", MaxValue: "9999", FieldLength: "4", Rotate: "YES", CurrentValue: "42"},
+			{Name: "IDOC_INVOICES", Description: "Invoices", MinValue: "1", MaxValue: "9999", FieldLength: "4", Rotate: "NO", CurrentValue: "5"},
+			{Name: "IDOC_DESADV", Description: "Delivery", MinValue: "1", MaxValue: "99999", FieldLength: "5", Rotate: "NO", CurrentValue: "3"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		withValues  bool
+		expRequests []string
+		expSummary  string
+	}{
+		{name: "definitions", expRequests: []string{"PUT /NumberRanges('IDOC_INVOICES')", "POST /NumberRanges"},
+			expSummary: "created: 1, updated: 1, unchanged: 1"},
+		{name: "withValues", withValues: true, expRequests: []string{"PUT /NumberRanges('IDOC_ORDERS')", "PUT /NumberRanges('IDOC_INVOICES')", "POST /NumberRanges"},
+			expSummary: "created: 1, updated: 2, unchanged: 0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests = nil
+			var out bytes.Buffer
+			err := client.ImportNumberRanges(&out, conf, doc, tc.withValues)
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if strings.Join(requests, "\n") != strings.Join(tc.expRequests, "\n") {
+				t.Errorf("Expected requests:\n%s\ngot:\n%s", strings.Join(tc.expRequests, "\n"), strings.Join(requests, "\n"))
+			}
+			if !strings.Contains(out.String(), tc.expSummary) {
+				t.Errorf("Unexpected output:\n%s", out.String())
+			}
+		})
+	}
+}
+
+func TestImportNumberRangesFailedRequest(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == "PUT":
+				w.WriteHeader(http.StatusInternalServerError)
+			case r.URL.Path == "/":
+				w.WriteHeader(http.StatusOK)
+			default:
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintln(w, `{"d": {"Name": "IDOC_ORDERS", "Description": "Orders", "MinValue": "1", "MaxValue": "9999", "FieldLength": "4", "Rotate": "YES", "CurrentValue": "17"}}`)
+			}
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	doc := &model.NumberRangeDocument{
+		NumberRanges: []model.NumberRangeDoc{
+			{Name: "IDOC_ORDERS", Description: "Orders changed", MinValue: "1", MaxValue: "9999", FieldLength: "4", Rotate: "YES"},
+		},
+	}
+
+	var out bytes.Buffer
+	err := client.ImportNumberRanges(&out, conf, doc, false)
+	if !errors.Is(err, client.ErrInvalidResponse) {
+		t.Errorf("Expected error %q, got %q.", client.ErrInvalidResponse, err)
+	}
+	if out.String() != "" {
+		t.Errorf("Expected no output for the failed request, got %q", out.String())
+	}
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func integrationPackageRequestBody(p model.IntegrationPackage) map[string]string {
+	requestBody := map[string]string{
+		"Id":          p.ID,
+		"Name":        p.Name,
+		"ShortText":   p.ShortText,
+		"Description": p.Description,
+	}
+	if requestBody["Name"] == "" {
+		requestBody["Name"] = p.ID
+	}
+	if requestBody["ShortText"] == "" {
+		requestBody["ShortText"] = requestBody["Name"]
+	}
+	if p.Version != "" {
+		requestBody["Version"] = p.Version
+	}
+	return requestBody
+}
+
+//CreateIntegrationPackage - create empty integration package, the short text is the name when not set
+func CreateIntegrationPackage(out io.Writer, conf config.Configuration, p model.IntegrationPackage) error {
+	if err := sendEntity(conf, "POST", conf.ApiURL+"/IntegrationPackages", integrationPackageRequestBody(p), nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Integration package: %s created\n", p.ID)
+	return nil
+}
+
+//UpdateIntegrationPackage - update name, short text and description of the integration package
+func UpdateIntegrationPackage(out io.Writer, conf config.Configuration, p model.IntegrationPackage) error {
+	if err := sendEntity(conf, "PUT", conf.ApiURL+"/IntegrationPackages("+odataKey(p.ID)+")", integrationPackageRequestBody(p), nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Integration package: %s updated\n", p.ID)
+	return nil
+}
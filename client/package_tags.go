@@ -0,0 +1,136 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func packageCustomTagsURL(conf config.Configuration, packageID string) string {
+	return conf.ApiURL + "/IntegrationPackages(" + odataKey(packageID) + ")/CustomTags"
+}
+
+//ParseTags parses the key=value pairs, the value may be empty
+func ParseTags(pairs []string) (model.CustomTags, error) {
+	var tags model.CustomTags
+	for _, pair := range pairs {
+		name, value, found := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("%w: tag %q, expected key=value", ErrInvalid, pair)
+		}
+		tags = append(tags, model.CustomTag{Name: name, Value: value})
+	}
+	return tags, nil
+}
+
+//RunGetIntegrationPackagesByTags - print the integration packages which have all the tags
+func RunGetIntegrationPackagesByTags(conf config.Configuration, pairs []string) {
+	tags, err := ParseTags(pairs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	resp, err := GetIntegrationPackagesByTags(conf, tags)
+	if err != nil {
+		log.Fatal("Error in GetIntegrationPackagesByTags: ", err)
+	}
+	resp.Print()
+}
+
+//GetIntegrationPackagesByTags - get list of the integration packages which have all the tags with the same values
+func GetIntegrationPackagesByTags(conf config.Configuration, tags model.CustomTags) (*model.IPResponse, error) {
+	packages, err := GetIntegrationPackages(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered model.IPResponse
+	for _, ip := range packages.D.Results {
+		packageTags, err := GetPackageCustomTags(conf, ip.ID)
+		if err != nil {
+			return nil, err
+		}
+		if hasTags(packageTags.D.Results, tags) {
+			ip.CustomTags = packageTags.D.Results
+			filtered.D.Results = append(filtered.D.Results, ip)
+		}
+	}
+	return &filtered, nil
+}
+
+func hasTags(packageTags model.CustomTags, tags model.CustomTags) bool {
+	for _, tag := range tags {
+		value, ok := packageTags.Get(tag.Name)
+		if !ok || value != tag.Value {
+			return false
+		}
+	}
+	return true
+}
+
+//InspectIntegrationPackageWithTags - get the integration package with its custom tags, the package is returned
+//without tags when the custom tags cannot be read, e.g. because of missing authorizations
+func InspectIntegrationPackageWithTags(conf config.Configuration, packageID string) (*model.IPByIdResponse, error) {
+	resp, err := InspectIntegrationPackage(conf, packageID)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := GetPackageCustomTags(conf, packageID)
+	if err != nil {
+		log.Printf("Integration package: %s custom tags not read: %s", packageID, err)
+		return resp, nil
+	}
+	resp.D.CustomTags = tags.D.Results
+	return resp, nil
+}
+
+//GetPackageCustomTags - get the custom tags of the integration package
+func GetPackageCustomTags(conf config.Configuration, packageID string) (*model.CustomTagsResponse, error) {
+	var decodedRes model.CustomTagsResponse
+	if err := getEntity(conf, packageCustomTagsURL(conf, packageID), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunTagIntegrationPackage - set the tags of the integration package, the current tags are printed when no tags are given
+func RunTagIntegrationPackage(out io.Writer, conf config.Configuration, packageID string, pairs []string) {
+	if len(pairs) == 0 {
+		resp, err := GetPackageCustomTags(conf, packageID)
+		if err != nil {
+			log.Fatal("Error in GetPackageCustomTags:\n", err)
+		}
+		resp.Print(out)
+		return
+	}
+
+	tags, err := ParseTags(pairs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = TagIntegrationPackage(out, conf, packageID, tags)
+	if err != nil {
+		log.Fatal("Error in TagIntegrationPackage:\n", err)
+	}
+}
+
+//TagIntegrationPackage - set values of the custom tags of the integration package, other tags are kept
+func TagIntegrationPackage(out io.Writer, conf config.Configuration, packageID string, tags model.CustomTags) error {
+	for _, tag := range tags {
+		tagURL := conf.ApiURL + "/IntegrationPackages(" + odataKey(packageID) + ")/$links/CustomTags(" + odataKey(tag.Name) + ")"
+		requestBody := map[string]string{
+			"Value": tag.Value,
+		}
+		if err := sendEntity(conf, "PUT", tagURL, requestBody, nil); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Custom tag: %s=%s set for package %s\n", tag.Name, tag.Value, packageID)
+	}
+	return nil
+}
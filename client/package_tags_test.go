@@ -0,0 +1,135 @@
+package client_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func TestGetIntegrationPackagesByTags(t *testing.T) {
+	responses := map[string]string{
+		"/IntegrationPackages": `{"d": {"results": [
+			{"Id": "Orders", "Name": "Orders", "CustomTags": {"__deferred": {"uri": "IntegrationPackages('Orders')/CustomTags"}}},
+			{"Id": "Invoices", "Name": "Invoices", "CustomTags": {"__deferred": {"uri": "IntegrationPackages('Invoices')/CustomTags"}}}
+		]}}`,
+		"/IntegrationPackages('Orders')/CustomTags":   `{"d": {"results": [{"Name": "owner", "Value": "sales"}, {"Name": "criticality", "Value": "high"}]}}`,
+		"/IntegrationPackages('Invoices')/CustomTags": `{"d": {"results": [{"Name": "owner", "Value": "finance"}, {"Name": "criticality", "Value": "high"}]}}`,
+	}
+
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, ok := responses[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, body)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	testCases := []struct {
+		name string
+		tags []string
+		exp  []string
+	}{
+		{name: "one", tags: []string{"owner=sales"}, exp: []string{"Orders"}},
+		{name: "all", tags: []string{"criticality=high"}, exp: []string{"Orders", "Invoices"}},
+		{name: "none", tags: []string{"criticality=high", "owner=hr"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tags, err := client.ParseTags(tc.tags)
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			resp, err := client.GetIntegrationPackagesByTags(conf, tags)
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			var ids []string
+			for _, ip := range resp.D.Results {
+				ids = append(ids, ip.ID)
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tc.exp) {
+				t.Errorf("Expected packages %v, got %v", tc.exp, ids)
+			}
+		})
+	}
+}
+
+func TestCustomTagsUnmarshal(t *testing.T) {
+	testCases := []struct {
+		name string
+		body string
+		exp  int
+	}{
+		{name: "deferred", body: `{"d": {"Id": "Orders", "CustomTags": {"__deferred": {"uri": "x"}}}}`, exp: 0},
+		{name: "expanded", body: `{"d": {"Id": "Orders", "CustomTags": {"results": [{"Name": "owner", "Value": "sales"}]}}}`, exp: 1},
+		{name: "list", body: `{"d": {"Id": "Orders", "CustomTags": [{"Name": "owner", "Value": "sales"}]}}`, exp: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var resp model.IPByIdResponse
+			if err := json.Unmarshal([]byte(tc.body), &resp); err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if len(resp.D.CustomTags) != tc.exp {
+				t.Errorf("Expected %d tags, got %d", tc.exp, len(resp.D.CustomTags))
+			}
+		})
+	}
+}
+
+func TestInspectIntegrationPackageWithTags(t *testing.T) {
+	tagsStatus := http.StatusOK
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/IntegrationPackages('Orders')":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, `{"d": {"Id": "Orders", "Name": "Orders"}}`)
+			case "/IntegrationPackages('Orders')/CustomTags":
+				w.WriteHeader(tagsStatus)
+				fmt.Fprint(w, `{"d": {"results": [{"Name": "owner", "Value": "sales"}]}}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	testCases := []struct {
+		name       string
+		tagsStatus int
+		exp        model.CustomTags
+	}{
+		{name: "tags", tagsStatus: http.StatusOK, exp: model.CustomTags{{Name: "owner", Value: "sales"}}},
+		{name: "tagsForbidden", tagsStatus: http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tagsStatus = tc.tagsStatus
+			resp, err := client.InspectIntegrationPackageWithTags(conf, "Orders")
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if resp.D.ID != "Orders" || !reflect.DeepEqual(resp.D.CustomTags, tc.exp) {
+				t.Errorf("Unexpected package %+v", resp.D)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func partnerURL(conf config.Configuration, pid string) string {
+	if pid == "" {
+		return conf.ApiURL + "/Partners"
+	}
+	return conf.ApiURL + "/Partners(" + odataKey(pid) + ")"
+}
+
+//RunGetPartners - call the function GetPartners
+func RunGetPartners(out io.Writer, conf config.Configuration) {
+	resp, err := GetPartners(conf)
+	if err != nil {
+		log.Fatal("Error in GetPartners:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetPartners - get list of the partners of the Partner Directory
+func GetPartners(conf config.Configuration) (*model.PartnersResponse, error) {
+	var decodedRes model.PartnersResponse
+	if err := getEntity(conf, partnerURL(conf, ""), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunGetPartner - print all string parameters, binary parameters and alternative partners of the partner
+func RunGetPartner(out io.Writer, conf config.Configuration, pid string) {
+	if err := getEntity(conf, partnerURL(conf, pid), &model.Partner{}); err != nil {
+		log.Fatal("Error in GetPartner:\n", err)
+	}
+
+	stringParameters, err := GetStringParameters(conf, pid)
+	if err != nil {
+		log.Fatal("Error in GetStringParameters:\n", err)
+	}
+	binaryParameters, err := GetBinaryParameters(conf, pid)
+	if err != nil {
+		log.Fatal("Error in GetBinaryParameters:\n", err)
+	}
+	alternativePartners, err := GetAlternativePartners(conf, pid)
+	if err != nil {
+		log.Fatal("Error in GetAlternativePartners:\n", err)
+	}
+
+	fmt.Fprintf(out, "Partner: %s\n\nString parameters:\n", pid)
+	stringParameters.Print(out)
+	fmt.Fprintf(out, "\nBinary parameters:\n")
+	binaryParameters.Print(out)
+	fmt.Fprintf(out, "\nAlternative partners:\n")
+	alternativePartners.Print(out)
+}
+
+//RunDeletePartner - call the function DeletePartner
+func RunDeletePartner(out io.Writer, conf config.Configuration, pid string) {
+	err := DeletePartner(out, conf, pid)
+	if err != nil {
+		log.Fatal("Error in DeletePartner:\n", err)
+	}
+}
+
+//DeletePartner - delete the partner with all its parameters and alternative partners
+func DeletePartner(out io.Writer, conf config.Configuration, pid string) error {
+	if err := sendEntity(conf, "DELETE", partnerURL(conf, pid), nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Partner: %s deleted\n", pid)
+	return nil
+}
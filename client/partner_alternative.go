@@ -0,0 +1,112 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//alternativePartnerURL - the key of the alternative partner is agency, scheme and id encoded as hex strings
+func alternativePartnerURL(conf config.Configuration, agency string, scheme string, id string) string {
+	return conf.ApiURL + "/AlternativePartners(Hexagency=" + odataKey(hexKey(agency)) +
+		",Hexscheme=" + odataKey(hexKey(scheme)) + ",Hexid=" + odataKey(hexKey(id)) + ")"
+}
+
+//RunGetAlternativePartners - call the function GetAlternativePartners
+func RunGetAlternativePartners(out io.Writer, conf config.Configuration, pid string) {
+	resp, err := GetAlternativePartners(conf, pid)
+	if err != nil {
+		log.Fatal("Error in GetAlternativePartners:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetAlternativePartners - get alternative partners of the partner, all alternative partners when pid is empty
+func GetAlternativePartners(conf config.Configuration, pid string) (*model.AlternativePartnersResponse, error) {
+	var decodedRes model.AlternativePartnersResponse
+	if err := getEntity(conf, partnerParametersURL(conf, "AlternativePartners", pid), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunGetAlternativePartner - call the function GetAlternativePartner
+func RunGetAlternativePartner(out io.Writer, conf config.Configuration, agency string, scheme string, id string) {
+	resp, err := GetAlternativePartner(conf, agency, scheme, id)
+	if err != nil {
+		log.Fatal("Error in GetAlternativePartner:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetAlternativePartner - get alternative partner by agency, scheme and id
+func GetAlternativePartner(conf config.Configuration, agency string, scheme string, id string) (*model.AlternativePartnerByIdResponse, error) {
+	var decodedRes model.AlternativePartnerByIdResponse
+	if err := getEntity(conf, alternativePartnerURL(conf, agency, scheme, id), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunCreateAlternativePartner - call the function CreateAlternativePartner
+func RunCreateAlternativePartner(out io.Writer, conf config.Configuration, agency string, scheme string, id string, pid string) {
+	err := CreateAlternativePartner(out, conf, agency, scheme, id, pid)
+	if err != nil {
+		log.Fatal("Error in CreateAlternativePartner:\n", err)
+	}
+}
+
+//CreateAlternativePartner - map agency, scheme and id to the partner
+func CreateAlternativePartner(out io.Writer, conf config.Configuration, agency string, scheme string, id string, pid string) error {
+	requestBody := map[string]string{
+		"Agency": agency,
+		"Scheme": scheme,
+		"Id":     id,
+		"Pid":    pid,
+	}
+	if err := sendEntity(conf, "POST", conf.ApiURL+"/AlternativePartners", requestBody, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Alternative partner: %s/%s/%s -> %s created\n", agency, scheme, id, pid)
+	return nil
+}
+
+//RunUpdateAlternativePartner - call the function UpdateAlternativePartner
+func RunUpdateAlternativePartner(out io.Writer, conf config.Configuration, agency string, scheme string, id string, pid string) {
+	err := UpdateAlternativePartner(out, conf, agency, scheme, id, pid)
+	if err != nil {
+		log.Fatal("Error in UpdateAlternativePartner:\n", err)
+	}
+}
+
+//UpdateAlternativePartner - map the alternative partner to another partner
+func UpdateAlternativePartner(out io.Writer, conf config.Configuration, agency string, scheme string, id string, pid string) error {
+	requestBody := map[string]string{
+		"Pid": pid,
+	}
+	if err := sendEntity(conf, "PUT", alternativePartnerURL(conf, agency, scheme, id), requestBody, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Alternative partner: %s/%s/%s -> %s updated\n", agency, scheme, id, pid)
+	return nil
+}
+
+//RunDeleteAlternativePartner - call the function DeleteAlternativePartner
+func RunDeleteAlternativePartner(out io.Writer, conf config.Configuration, agency string, scheme string, id string) {
+	err := DeleteAlternativePartner(out, conf, agency, scheme, id)
+	if err != nil {
+		log.Fatal("Error in DeleteAlternativePartner:\n", err)
+	}
+}
+
+//DeleteAlternativePartner - delete alternative partner
+func DeleteAlternativePartner(out io.Writer, conf config.Configuration, agency string, scheme string, id string) error {
+	if err := sendEntity(conf, "DELETE", alternativePartnerURL(conf, agency, scheme, id), nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Alternative partner: %s/%s/%s deleted\n", agency, scheme, id)
+	return nil
+}
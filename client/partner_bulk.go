@@ -0,0 +1,239 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+	"gopkg.in/yaml.v3"
+)
+
+//PartnerDocumentFormat returns json or yaml format of the partner file, the format flag takes precedence over the file extension
+func PartnerDocumentFormat(fileName string, format string) (string, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(fileName)) {
+		case ".yaml", ".yml":
+			format = "yaml"
+		default:
+			format = "json"
+		}
+	}
+	format = strings.ToLower(format)
+	if format != "json" && format != "yaml" {
+		return "", fmt.Errorf("%w: unknown format %s, available values: json, yaml", ErrInvalid, format)
+	}
+	return format, nil
+}
+
+//RunExportPartner - call the function ExportPartner and write the document to the file or to the output
+func RunExportPartner(out io.Writer, conf config.Configuration, pid string, fileName string, format string) {
+	format, err := PartnerDocumentFormat(fileName, format)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	doc, err := ExportPartner(conf, pid)
+	if err != nil {
+		log.Fatal("Error in ExportPartner:\n", err)
+	}
+
+	content, err := encodePartnerDocument(doc, format)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if fileName == "" {
+		out.Write(content)
+		return
+	}
+	if err := os.WriteFile(fileName, content, 0666); err != nil {
+		log.Fatal("Error writing file:\n", err)
+	}
+	fmt.Fprintf(out, "Partner: %s exported to %s\n", pid, fileName)
+}
+
+//ExportPartner - read all string parameters, binary parameters and alternative partners of the partner
+func ExportPartner(conf config.Configuration, pid string) (*model.PartnerDocument, error) {
+	stringParameters, err := GetStringParameters(conf, pid)
+	if err != nil {
+		return nil, err
+	}
+	binaryParameters, err := GetBinaryParameters(conf, pid)
+	if err != nil {
+		return nil, err
+	}
+	alternativePartners, err := GetAlternativePartners(conf, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &model.PartnerDocument{Pid: pid}
+	for _, p := range stringParameters.D.Results {
+		doc.StringParameters = append(doc.StringParameters, model.PartnerStringParameterDoc{Id: p.Id, Value: p.Value})
+	}
+	for _, p := range binaryParameters.D.Results {
+		doc.BinaryParameters = append(doc.BinaryParameters, model.PartnerBinaryParameterDoc{Id: p.Id, ContentType: p.ContentType, Value: p.Value})
+	}
+	for _, p := range alternativePartners.D.Results {
+		doc.AlternativePartners = append(doc.AlternativePartners, model.PartnerAlternativeDoc{Agency: p.Agency, Scheme: p.Scheme, Id: p.Id})
+	}
+	if len(doc.StringParameters)+len(doc.BinaryParameters)+len(doc.AlternativePartners) == 0 {
+		return nil, fmt.Errorf("%w: partner %s has no parameters", ErrNotFound, pid)
+	}
+
+	return doc, nil
+}
+
+//RunImportPartner - read the partner file and call the function ImportPartner
+func RunImportPartner(out io.Writer, conf config.Configuration, fileName string, format string, pid string) {
+	format, err := PartnerDocumentFormat(fileName, format)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		log.Fatal("Error reading file:\n", err)
+	}
+
+	doc, err := decodePartnerDocument(content, format)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if pid != "" {
+		doc.Pid = pid
+	}
+
+	err = ImportPartner(out, conf, doc)
+	if err != nil {
+		log.Fatal("Error in ImportPartner:\n", err)
+	}
+}
+
+//ImportPartner - create missing and update changed parameters and alternative partners of the partner from the document.
+//Parameters which are not in the document are kept.
+func ImportPartner(out io.Writer, conf config.Configuration, doc *model.PartnerDocument) error {
+	if doc.Pid == "" {
+		return fmt.Errorf("%w: pid of the partner not set", ErrInvalid)
+	}
+	pid := doc.Pid
+
+	created, updated, unchanged := 0, 0, 0
+	// the messages of the single requests are not printed, one line per entry is printed instead
+	discard := io.Discard
+
+	for _, p := range doc.StringParameters {
+		current, err := GetStringParameter(conf, pid, p.Id)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			if err = CreateStringParameter(discard, conf, pid, p.Id, p.Value); err == nil {
+				created++
+				fmt.Fprintf(out, "+ string %s\n", p.Id)
+			}
+		case err != nil:
+		case current.D.Value == p.Value:
+			unchanged++
+		default:
+			if err = UpdateStringParameter(discard, conf, pid, p.Id, p.Value); err == nil {
+				updated++
+				fmt.Fprintf(out, "~ string %s\n", p.Id)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, p := range doc.BinaryParameters {
+		content, err := base64.StdEncoding.DecodeString(p.Value)
+		if err != nil {
+			return fmt.Errorf("%w: value of binary parameter %s is not base64: %s", ErrInvalid, p.Id, err)
+		}
+		current, err := GetBinaryParameter(conf, pid, p.Id)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			if err = CreateBinaryParameter(discard, conf, pid, p.Id, p.ContentType, content); err == nil {
+				created++
+				fmt.Fprintf(out, "+ binary %s\n", p.Id)
+			}
+		case err != nil:
+		case current.D.ContentType == p.ContentType && sameBase64Content(current.D.Value, content):
+			unchanged++
+		default:
+			if err = UpdateBinaryParameter(discard, conf, pid, p.Id, p.ContentType, content); err == nil {
+				updated++
+				fmt.Fprintf(out, "~ binary %s\n", p.Id)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, p := range doc.AlternativePartners {
+		current, err := GetAlternativePartner(conf, p.Agency, p.Scheme, p.Id)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			if err = CreateAlternativePartner(discard, conf, p.Agency, p.Scheme, p.Id, pid); err == nil {
+				created++
+				fmt.Fprintf(out, "+ alternative %s/%s/%s\n", p.Agency, p.Scheme, p.Id)
+			}
+		case err != nil:
+		case current.D.Pid == pid:
+			unchanged++
+		default:
+			if err = UpdateAlternativePartner(discard, conf, p.Agency, p.Scheme, p.Id, pid); err == nil {
+				updated++
+				fmt.Fprintf(out, "~ alternative %s/%s/%s (was %s)\n", p.Agency, p.Scheme, p.Id, current.D.Pid)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "Partner: %s imported, created: %d, updated: %d, unchanged: %d\n", pid, created, updated, unchanged)
+	return nil
+}
+
+func sameBase64Content(value string, content []byte) bool {
+	current, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(current, content)
+}
+
+func encodePartnerDocument(doc *model.PartnerDocument, format string) ([]byte, error) {
+	if format == "yaml" {
+		return yaml.Marshal(doc)
+	}
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func decodePartnerDocument(content []byte, format string) (*model.PartnerDocument, error) {
+	var doc model.PartnerDocument
+	var err error
+	if format == "yaml" {
+		err = yaml.Unmarshal(content, &doc)
+	} else {
+		err = json.Unmarshal(content, &doc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot parse partner file: %s", ErrInvalid, err)
+	}
+	return &doc, nil
+}
@@ -0,0 +1,264 @@
+package client
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//binaryParameterContentTypes - content types supported by the binary parameters of the Partner Directory
+var binaryParameterContentTypes = map[string]bool{
+	"xml": true, "xsl": true, "xsd": true, "json": true, "txt": true, "zip": true, "gz": true, "zlib": true, "crt": true,
+}
+
+func partnerParametersURL(conf config.Configuration, entitySet string, pid string) string {
+	if pid == "" {
+		return conf.ApiURL + "/" + entitySet
+	}
+	return conf.ApiURL + "/" + entitySet + "?" + odataFilter("Pid eq "+odataKey(pid))
+}
+
+func partnerParameterURL(conf config.Configuration, entitySet string, pid string, id string) string {
+	return conf.ApiURL + "/" + entitySet + "(Pid=" + odataKey(pid) + ",Id=" + odataKey(id) + ")"
+}
+
+//BinaryParameterContentTypeFromFileName returns content type of the binary parameter based on the file extension
+func BinaryParameterContentTypeFromFileName(fileName string) (string, error) {
+	contentType := strings.ToLower(strings.TrimPrefix(filepath.Ext(fileName), "."))
+	if contentType == "xslt" {
+		contentType = "xsl"
+	}
+	if !binaryParameterContentTypes[contentType] {
+		return "", fmt.Errorf("%w: cannot infer content type from file name %s, use --content-type flag", ErrInvalid, fileName)
+	}
+	return contentType, nil
+}
+
+//RunGetStringParameters - call the function GetStringParameters
+func RunGetStringParameters(out io.Writer, conf config.Configuration, pid string) {
+	resp, err := GetStringParameters(conf, pid)
+	if err != nil {
+		log.Fatal("Error in GetStringParameters:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetStringParameters - get string parameters of the partner, all string parameters when pid is empty
+func GetStringParameters(conf config.Configuration, pid string) (*model.StringParametersResponse, error) {
+	var decodedRes model.StringParametersResponse
+	if err := getEntity(conf, partnerParametersURL(conf, "StringParameters", pid), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunGetStringParameter - call the function GetStringParameter
+func RunGetStringParameter(out io.Writer, conf config.Configuration, pid string, id string) {
+	resp, err := GetStringParameter(conf, pid, id)
+	if err != nil {
+		log.Fatal("Error in GetStringParameter:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetStringParameter - get string parameter of the partner by id
+func GetStringParameter(conf config.Configuration, pid string, id string) (*model.StringParameterByIdResponse, error) {
+	var decodedRes model.StringParameterByIdResponse
+	if err := getEntity(conf, partnerParameterURL(conf, "StringParameters", pid, id), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunCreateStringParameter - call the function CreateStringParameter
+func RunCreateStringParameter(out io.Writer, conf config.Configuration, pid string, id string, value string) {
+	err := CreateStringParameter(out, conf, pid, id, value)
+	if err != nil {
+		log.Fatal("Error in CreateStringParameter:\n", err)
+	}
+}
+
+//CreateStringParameter - add string parameter to the partner
+func CreateStringParameter(out io.Writer, conf config.Configuration, pid string, id string, value string) error {
+	requestBody := map[string]string{
+		"Pid":   pid,
+		"Id":    id,
+		"Value": value,
+	}
+	if err := sendEntity(conf, "POST", conf.ApiURL+"/StringParameters", requestBody, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "String parameter: %s/%s created\n", pid, id)
+	return nil
+}
+
+//RunUpdateStringParameter - call the function UpdateStringParameter
+func RunUpdateStringParameter(out io.Writer, conf config.Configuration, pid string, id string, value string) {
+	err := UpdateStringParameter(out, conf, pid, id, value)
+	if err != nil {
+		log.Fatal("Error in UpdateStringParameter:\n", err)
+	}
+}
+
+//UpdateStringParameter - change value of the string parameter
+func UpdateStringParameter(out io.Writer, conf config.Configuration, pid string, id string, value string) error {
+	requestBody := map[string]string{
+		"Value": value,
+	}
+	if err := sendEntity(conf, "PUT", partnerParameterURL(conf, "StringParameters", pid, id), requestBody, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "String parameter: %s/%s updated\n", pid, id)
+	return nil
+}
+
+//RunDeleteStringParameter - call the function DeleteStringParameter
+func RunDeleteStringParameter(out io.Writer, conf config.Configuration, pid string, id string) {
+	err := DeleteStringParameter(out, conf, pid, id)
+	if err != nil {
+		log.Fatal("Error in DeleteStringParameter:\n", err)
+	}
+}
+
+//DeleteStringParameter - delete string parameter of the partner
+func DeleteStringParameter(out io.Writer, conf config.Configuration, pid string, id string) error {
+	if err := sendEntity(conf, "DELETE", partnerParameterURL(conf, "StringParameters", pid, id), nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "String parameter: %s/%s deleted\n", pid, id)
+	return nil
+}
+
+//RunGetBinaryParameters - call the function GetBinaryParameters
+func RunGetBinaryParameters(out io.Writer, conf config.Configuration, pid string) {
+	resp, err := GetBinaryParameters(conf, pid)
+	if err != nil {
+		log.Fatal("Error in GetBinaryParameters:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetBinaryParameters - get binary parameters of the partner, all binary parameters when pid is empty
+func GetBinaryParameters(conf config.Configuration, pid string) (*model.BinaryParametersResponse, error) {
+	var decodedRes model.BinaryParametersResponse
+	if err := getEntity(conf, partnerParametersURL(conf, "BinaryParameters", pid), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunDownloadBinaryParameter - call the function GetBinaryParameter and save the decoded value,
+//the value is written to the output when outputFile is empty
+func RunDownloadBinaryParameter(out io.Writer, conf config.Configuration, pid string, id string, outputFile string) {
+	resp, err := GetBinaryParameter(conf, pid, id)
+	if err != nil {
+		log.Fatal("Error in GetBinaryParameter:\n", err)
+	}
+	content, err := base64.StdEncoding.DecodeString(resp.D.Value)
+	if err != nil {
+		log.Fatal("Error decoding binary parameter:\n", err)
+	}
+
+	if outputFile == "" {
+		out.Write(content)
+		return
+	}
+	if err := os.WriteFile(outputFile, content, 0666); err != nil {
+		log.Fatal("Error writing file:\n", err)
+	}
+	fmt.Fprintf(out, "Binary parameter: %s/%s (%s) saved to %s\n", pid, id, resp.D.ContentType, outputFile)
+}
+
+//GetBinaryParameter - get binary parameter of the partner by id
+func GetBinaryParameter(conf config.Configuration, pid string, id string) (*model.BinaryParameterByIdResponse, error) {
+	var decodedRes model.BinaryParameterByIdResponse
+	if err := getEntity(conf, partnerParameterURL(conf, "BinaryParameters", pid, id), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunCreateBinaryParameter - call the function CreateBinaryParameter with content of the file
+func RunCreateBinaryParameter(out io.Writer, conf config.Configuration, pid string, id string, contentType string, fileName string) {
+	contentType, content := readBinaryParameterFile(contentType, fileName)
+	err := CreateBinaryParameter(out, conf, pid, id, contentType, content)
+	if err != nil {
+		log.Fatal("Error in CreateBinaryParameter:\n", err)
+	}
+}
+
+//CreateBinaryParameter - add binary parameter to the partner
+func CreateBinaryParameter(out io.Writer, conf config.Configuration, pid string, id string, contentType string, content []byte) error {
+	requestBody := map[string]string{
+		"Pid":         pid,
+		"Id":          id,
+		"ContentType": contentType,
+		"Value":       base64.StdEncoding.EncodeToString(content),
+	}
+	if err := sendEntity(conf, "POST", conf.ApiURL+"/BinaryParameters", requestBody, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Binary parameter: %s/%s created\n", pid, id)
+	return nil
+}
+
+//RunUpdateBinaryParameter - call the function UpdateBinaryParameter with content of the file
+func RunUpdateBinaryParameter(out io.Writer, conf config.Configuration, pid string, id string, contentType string, fileName string) {
+	contentType, content := readBinaryParameterFile(contentType, fileName)
+	err := UpdateBinaryParameter(out, conf, pid, id, contentType, content)
+	if err != nil {
+		log.Fatal("Error in UpdateBinaryParameter:\n", err)
+	}
+}
+
+//UpdateBinaryParameter - change content of the binary parameter
+func UpdateBinaryParameter(out io.Writer, conf config.Configuration, pid string, id string, contentType string, content []byte) error {
+	requestBody := map[string]string{
+		"ContentType": contentType,
+		"Value":       base64.StdEncoding.EncodeToString(content),
+	}
+	if err := sendEntity(conf, "PUT", partnerParameterURL(conf, "BinaryParameters", pid, id), requestBody, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Binary parameter: %s/%s updated\n", pid, id)
+	return nil
+}
+
+//RunDeleteBinaryParameter - call the function DeleteBinaryParameter
+func RunDeleteBinaryParameter(out io.Writer, conf config.Configuration, pid string, id string) {
+	err := DeleteBinaryParameter(out, conf, pid, id)
+	if err != nil {
+		log.Fatal("Error in DeleteBinaryParameter:\n", err)
+	}
+}
+
+//DeleteBinaryParameter - delete binary parameter of the partner
+func DeleteBinaryParameter(out io.Writer, conf config.Configuration, pid string, id string) error {
+	if err := sendEntity(conf, "DELETE", partnerParameterURL(conf, "BinaryParameters", pid, id), nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Binary parameter: %s/%s deleted\n", pid, id)
+	return nil
+}
+
+func readBinaryParameterFile(contentType string, fileName string) (string, []byte) {
+	if contentType == "" {
+		var err error
+		contentType, err = BinaryParameterContentTypeFromFileName(fileName)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		log.Fatal("Error reading file:\n", err)
+	}
+	return contentType, content
+}
@@ -0,0 +1,149 @@
+package client_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func TestPartnerDocumentFormat(t *testing.T) {
+	testCases := []struct {
+		fileName  string
+		format    string
+		expFormat string
+		expError  bool
+	}{
+		{fileName: "SenderA.yaml", expFormat: "yaml"},
+		{fileName: "SenderA.YML", expFormat: "yaml"},
+		{fileName: "SenderA.json", expFormat: "json"},
+		{fileName: "", expFormat: "json"},
+		{fileName: "SenderA.txt", format: "YAML", expFormat: "yaml"},
+		{fileName: "SenderA.json", format: "xml", expError: true},
+	}
+
+	for _, tc := range testCases {
+		format, err := client.PartnerDocumentFormat(tc.fileName, tc.format)
+		if tc.expError {
+			if err == nil {
+				t.Errorf("%s: expected error, got format %s", tc.fileName, format)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Expected no error, got %q.", err)
+		}
+		if format != tc.expFormat {
+			t.Errorf("%s: expected format %s, got %s", tc.fileName, tc.expFormat, format)
+		}
+	}
+}
+
+func TestImportPartner(t *testing.T) {
+	existing := map[string]string{
+		"/StringParameters(Pid='SenderA',Id='URL')":                                        `{"d": {"Pid": "SenderA", "Id": "URL", "Value": "https://example.com"}}`,
+		"/StringParameters(Pid='SenderA',Id='Timeout')":                                    `{"d": {"Pid": "SenderA", "Id": "Timeout", "Value": "30"}}`,
+		"/AlternativePartners(Hexagency='534150',Hexscheme='53656e646572',Hexid='313030')": `{"d": {"Agency": "SAP", "Scheme": "Sender", "Id": "100", "Pid": "OldSender"}}`,
+	}
+
+	var requests []string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "GET" {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			if r.URL.Path == "/" {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			body, ok := existing[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, body)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	doc := &model.PartnerDocument{
+		Pid: "SenderA",
+		StringParameters: []model.PartnerStringParameterDoc{
+			{Id: "URL", Value: "https://example.com"},
+			{Id: "Timeout", Value: "60"},
+			{Id: "User", Value: "sender"},
+		},
+		BinaryParameters: []model.PartnerBinaryParameterDoc{
+			{Id: "Mapping", ContentType: "xsl", Value: encodeBase64("<xsl:stylesheet/>")},
+		},
+		AlternativePartners: []model.PartnerAlternativeDoc{
+			{Agency: "SAP", Scheme: "Sender", Id: "100"},
+		},
+	}
+
+	var out bytes.Buffer
+	err := client.ImportPartner(&out, conf, doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	sort.Strings(requests)
+	expRequests := []string{
+		"POST /BinaryParameters",
+		"POST /StringParameters",
+		"PUT /AlternativePartners(Hexagency='534150',Hexscheme='53656e646572',Hexid='313030')",
+		"PUT /StringParameters(Pid='SenderA',Id='Timeout')",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expRequests, "\n") {
+		t.Errorf("Expected requests:\n%s\ngot:\n%s", strings.Join(expRequests, "\n"), strings.Join(requests, "\n"))
+	}
+	if !strings.Contains(out.String(), "created: 2, updated: 2, unchanged: 1") {
+		t.Errorf("Unexpected output:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "~ alternative SAP/Sender/100 (was OldSender)") {
+		t.Errorf("Expected remapped alternative partner in output:\n%s", out.String())
+	}
+}
+
+func TestImportPartnerFailedRequest(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == "POST":
+				w.WriteHeader(http.StatusInternalServerError)
+			case r.URL.Path == "/":
+				w.WriteHeader(http.StatusOK)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	doc := &model.PartnerDocument{
+		Pid:              "SenderA",
+		StringParameters: []model.PartnerStringParameterDoc{{Id: "User", Value: "sender"}},
+	}
+
+	var out bytes.Buffer
+	err := client.ImportPartner(&out, conf, doc)
+	if !errors.Is(err, client.ErrInvalidResponse) {
+		t.Errorf("Expected error %q, got %q.", client.ErrInvalidResponse, err)
+	}
+	if out.String() != "" {
+		t.Errorf("Expected no output for the failed request, got %q", out.String())
+	}
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//getEntity sends GET request and decodes the JSON response into decodedRes
+func getEntity(conf config.Configuration, entityURL string, decodedRes interface{}) error {
+	body, err := getEntityContent(conf, entityURL, "application/json")
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, decodedRes); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidResponse, err)
+	}
+
+	return nil
+}
+
+//getEntityContent sends GET request and returns the raw response body
+func getEntityContent(conf config.Configuration, entityURL string, accept string) ([]byte, error) {
+	log.Println("GET ", entityURL)
+
+	request, err := http.NewRequest("GET", entityURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(response.Body)
+}
+
+//sendEntity sends POST, PUT or DELETE request with the JSON body protected by the CSRF token.
+//The response is decoded into decodedRes when it is not nil and the response has a body.
+func sendEntity(conf config.Configuration, method string, entityURL string, requestBody interface{}, decodedRes interface{}) error {
+	var contentType string
+	var body io.Reader
+	switch b := requestBody.(type) {
+	case nil:
+	case []byte:
+		body = bytes.NewReader(b)
+	default:
+		requestBodyJSON, err := json.Marshal(requestBody)
+		if err != nil {
+			return err
+		}
+		contentType = "application/json"
+		body = bytes.NewReader(requestBodyJSON)
+	}
+
+	return sendEntityContent(conf, method, entityURL, contentType, body, decodedRes)
+}
+
+func sendEntityContent(conf config.Configuration, method string, entityURL string, contentType string, body io.Reader, decodedRes interface{}) error {
+	csrfToken, cookies, err := getCsrfTokenAndCookies(conf)
+	if err != nil {
+		return err
+	}
+
+	log.Println(method, " ", entityURL)
+
+	request, err := http.NewRequest(method, entityURL, body)
+	if err != nil {
+		return err
+	}
+
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-CSRF-Token", csrfToken)
+	for i := range cookies {
+		request.AddCookie(cookies[i])
+	}
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return err
+	}
+
+	if decodedRes == nil {
+		return nil
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(responseBody)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(responseBody, decodedRes); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidResponse, err)
+	}
+
+	return nil
+}
+
+//odataKey quotes the value of the OData key, single quotes are escaped by doubling them
+func odataKey(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+//hexKey returns the value encoded as hex string, used by the keys of keystore entries and alternative partners
+func hexKey(value string) string {
+	return hex.EncodeToString([]byte(value))
+}
+
+//odataFilter returns the $filter query option with the escaped expression
+func odataFilter(expression string) string {
+	return "$filter=" + strings.ReplaceAll(url.QueryEscape(expression), "+", "%20")
+}
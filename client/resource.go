@@ -0,0 +1,42 @@
+package client
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+var resourceTypesByExtension = map[string]string{
+	".edmx":   "edmx",
+	".groovy": "groovy",
+	".gsh":    "groovy",
+	".jar":    "jar",
+	".js":     "js",
+	".mmap":   "mmap",
+	".opmap":  "opmap",
+	".wsdl":   "wsdl",
+	".xsd":    "xsd",
+	".xsl":    "xslt",
+	".xslt":   "xslt",
+}
+
+//ResourceTypeFromFileName returns resource type of the integration flow resource based on the file extension
+func ResourceTypeFromFileName(fileName string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(fileName))
+	resourceType, ok := resourceTypesByExtension[ext]
+	if !ok {
+		return "", fmt.Errorf("%w: cannot infer resource type from file name %s, use --resource-type flag", ErrInvalid, fileName)
+	}
+	return resourceType, nil
+}
+
+func resourcesURL(conf config.Configuration, flowID string, flowVersion string) string {
+	return conf.ApiURL + "/IntegrationDesigntimeArtifacts(Id='" + flowID + "',Version='" + flowVersion + "')/Resources"
+}
+
+func resourceURL(conf config.Configuration, flowID string, flowVersion string, resourceName string, resourceType string) string {
+	return conf.ApiURL + "/IntegrationDesigntimeArtifacts(Id='" + flowID + "',Version='" + flowVersion + "')" +
+		"/$links/Resources(Name='" + resourceName + "',ResourceType='" + resourceType + "')"
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunResourceCreate - call ResourceCreate
+func RunResourceCreate(out io.Writer, conf config.Configuration, flowID string, flowVersion string, resourceName string, resourceType string, resourceFileName string) {
+	err := ResourceCreate(out, conf, flowID, flowVersion, resourceName, resourceType, resourceFileName)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+//ResourceCreate - add new resource to the integration flow
+func ResourceCreate(out io.Writer, conf config.Configuration, flowID string, flowVersion string, resourceName string, resourceType string, resourceFileName string) error {
+	if resourceName == "" {
+		resourceName = filepath.Base(resourceFileName)
+	}
+
+	if resourceType == "" {
+		var err error
+		resourceType, err = ResourceTypeFromFileName(resourceFileName)
+		if err != nil {
+			return err
+		}
+	}
+
+	contentData, err := os.ReadFile(resourceFileName)
+	if err != nil {
+		return err
+	}
+
+	return createResource(out, conf, flowID, flowVersion, resourceName, resourceType, contentData)
+}
+
+func createResource(out io.Writer, conf config.Configuration, flowID string, flowVersion string, resourceName string, resourceType string, contentData []byte) error {
+	csrfToken, cookies, err := getCsrfTokenAndCookies(conf)
+	if err != nil {
+		return err
+	}
+
+	requestBody := map[string]string{
+		"Name":            resourceName,
+		"ResourceType":    resourceType,
+		"ResourceContent": base64.StdEncoding.EncodeToString(contentData),
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	createResourceURL := resourcesURL(conf, flowID, flowVersion)
+	log.Println("POST ", createResourceURL)
+
+	request, err := http.NewRequest("POST", createResourceURL, bytes.NewBuffer(requestBodyJSON))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-CSRF-Token", csrfToken)
+	for i := range cookies {
+		request.AddCookie(cookies[i])
+	}
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Resource: %s (%s) created\n", resourceName, resourceType)
+	return nil
+}
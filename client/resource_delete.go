@@ -0,0 +1,63 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunResourceDelete - call ResourceDelete
+func RunResourceDelete(out io.Writer, conf config.Configuration, flowID string, flowVersion string, resourceName string, resourceType string) {
+	err := ResourceDelete(out, conf, flowID, flowVersion, resourceName, resourceType)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+//ResourceDelete - delete resource of the integration flow
+func ResourceDelete(out io.Writer, conf config.Configuration, flowID string, flowVersion string, resourceName string, resourceType string) error {
+	if resourceType == "" {
+		var err error
+		resourceType, err = ResourceTypeFromFileName(resourceName)
+		if err != nil {
+			return err
+		}
+	}
+
+	csrfToken, cookies, err := getCsrfTokenAndCookies(conf)
+	if err != nil {
+		return err
+	}
+
+	deleteResourceURL := resourceURL(conf, flowID, flowVersion, resourceName, resourceType)
+	log.Println("DELETE ", deleteResourceURL)
+
+	request, err := http.NewRequest("DELETE", deleteResourceURL, nil)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-CSRF-Token", csrfToken)
+	for i := range cookies {
+		request.AddCookie(cookies[i])
+	}
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Resource: %s (%s) deleted\n", resourceName, resourceType)
+	return nil
+}
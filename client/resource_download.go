@@ -0,0 +1,99 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//RunDownloadResources - call the function DownloadResources
+func RunDownloadResources(out io.Writer, conf config.Configuration, flowID string, flowVersion string, resourceName string, resourceType string, targetDir string) {
+	err := DownloadResources(out, conf, flowID, flowVersion, resourceName, resourceType, targetDir)
+	if err != nil {
+		log.Fatal("Error in DownloadResources:\n", err)
+	}
+}
+
+//DownloadResources - download one resource or all resources of the integration flow into the target directory
+func DownloadResources(out io.Writer, conf config.Configuration, flowID string, flowVersion string, resourceName string, resourceType string, targetDir string) error {
+	var resources []model.Resource
+
+	if resourceName != "" {
+		if resourceType == "" {
+			var err error
+			resourceType, err = ResourceTypeFromFileName(resourceName)
+			if err != nil {
+				return err
+			}
+		}
+		resources = append(resources, model.Resource{Name: resourceName, ResourceType: resourceType})
+	} else {
+		resp, err := GetResources(conf, flowID, flowVersion)
+		if err != nil {
+			return err
+		}
+		resources = resp.D.Results
+	}
+
+	if err := os.MkdirAll(targetDir, os.ModePerm); err != nil {
+		return err
+	}
+
+	for _, r := range resources {
+		content, err := DownloadResource(conf, flowID, flowVersion, r.Name, r.ResourceType)
+		if err != nil {
+			return err
+		}
+		fileName := filepath.Join(targetDir, filepath.Base(r.Name))
+		if err := os.WriteFile(fileName, content, 0666); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Resource: %s (%s) saved to %s\n", r.Name, r.ResourceType, fileName)
+	}
+
+	return nil
+}
+
+//DownloadResource - get decoded content of the resource of the integration flow
+func DownloadResource(conf config.Configuration, flowID string, flowVersion string, resourceName string, resourceType string) ([]byte, error) {
+	getResourceURL := resourceURL(conf, flowID, flowVersion, resourceName, resourceType)
+	log.Println("GET ", getResourceURL)
+
+	request, err := http.NewRequest("GET", getResourceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return nil, err
+	}
+
+	var decodedRes model.ResourceByIdResponse
+	if err := json.NewDecoder(response.Body).Decode(&decodedRes); err != nil {
+		return nil, err
+	}
+
+	content, err := base64.StdEncoding.DecodeString(decodedRes.D.ResourceContent)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot decode content of resource %s: %s", ErrInvalidResponse, resourceName, err)
+	}
+
+	return content, nil
+}
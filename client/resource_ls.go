@@ -0,0 +1,52 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//RunGetResources - call the function GetResources
+func RunGetResources(out io.Writer, conf config.Configuration, flowID string, flowVersion string) {
+	resp, err := GetResources(conf, flowID, flowVersion)
+	if err != nil {
+		log.Fatal("Error in GetResources:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetResources - get list of resources of the integration flow
+func GetResources(conf config.Configuration, flowID string, flowVersion string) (*model.ResourcesResponse, error) {
+	getResourcesURL := resourcesURL(conf, flowID, flowVersion)
+	log.Println("GET ", getResourcesURL)
+
+	request, err := http.NewRequest("GET", getResourcesURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	httpClient := getClient(conf)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrConnection, err)
+	}
+	defer response.Body.Close()
+
+	if err := checkResponseStatus(response); err != nil {
+		return nil, err
+	}
+
+	var decodedRes model.ResourcesResponse
+	if err := json.NewDecoder(response.Body).Decode(&decodedRes); err != nil {
+		return nil, err
+	}
+
+	return &decodedRes, nil
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+const (
+	resourceSyncCreate    = "create"
+	resourceSyncUpdate    = "update"
+	resourceSyncDelete    = "delete"
+	resourceSyncOrphan    = "orphan"
+	resourceSyncUnchanged = "unchanged"
+)
+
+type resourceSyncAction struct {
+	Action       string
+	Name         string
+	ResourceType string
+	Content      []byte
+}
+
+//RunSyncResources - call the function SyncResources
+func RunSyncResources(out io.Writer, conf config.Configuration, flowID string, flowVersion string, dir string, deleteOrphans bool, dryRun bool) {
+	err := SyncResources(out, conf, flowID, flowVersion, dir, deleteOrphans, dryRun)
+	if err != nil {
+		log.Fatal("Error in SyncResources:\n", err)
+	}
+}
+
+//SyncResources - compare files from the directory with resources of the integration flow by name and content hash,
+//create missing resources, update changed resources and optionally delete resources without local file.
+//The plan is printed first, with dryRun nothing is changed.
+func SyncResources(out io.Writer, conf config.Configuration, flowID string, flowVersion string, dir string, deleteOrphans bool, dryRun bool) error {
+	plan, err := planResourceSync(conf, flowID, flowVersion, dir, deleteOrphans)
+	if err != nil {
+		return err
+	}
+
+	counts := map[string]int{}
+	fmt.Fprintf(out, "Resource sync plan for integration flow: %s\n", flowID)
+	for _, a := range plan {
+		counts[a.Action]++
+		fmt.Fprintf(out, "  %-10s %s (%s)\n", a.Action, a.Name, a.ResourceType)
+	}
+	fmt.Fprintf(out, "create: %d, update: %d, delete: %d, unchanged: %d, orphan: %d\n",
+		counts[resourceSyncCreate], counts[resourceSyncUpdate], counts[resourceSyncDelete], counts[resourceSyncUnchanged], counts[resourceSyncOrphan])
+
+	if dryRun {
+		fmt.Fprintln(out, "Dry run, no changes applied.")
+		return nil
+	}
+
+	for _, a := range plan {
+		switch a.Action {
+		case resourceSyncCreate:
+			err = createResource(out, conf, flowID, flowVersion, a.Name, a.ResourceType, a.Content)
+		case resourceSyncUpdate:
+			err = updateResource(out, conf, flowID, flowVersion, a.Name, a.ResourceType, a.Content)
+		case resourceSyncDelete:
+			err = ResourceDelete(out, conf, flowID, flowVersion, a.Name, a.ResourceType)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func planResourceSync(conf config.Configuration, flowID string, flowVersion string, dir string, deleteOrphans bool) ([]resourceSyncAction, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	localFiles := map[string]resourceSyncAction{}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		resourceType, err := ResourceTypeFromFileName(e.Name())
+		if err != nil {
+			log.Printf("File: %s skipped: %s\n", e.Name(), err)
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		localFiles[e.Name()] = resourceSyncAction{Name: e.Name(), ResourceType: resourceType, Content: content}
+	}
+
+	resp, err := GetResources(conf, flowID, flowVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan []resourceSyncAction
+	for _, r := range resp.D.Results {
+		local, ok := localFiles[r.Name]
+		if !ok {
+			action := resourceSyncOrphan
+			if deleteOrphans {
+				action = resourceSyncDelete
+			}
+			plan = append(plan, resourceSyncAction{Action: action, Name: r.Name, ResourceType: r.ResourceType})
+			continue
+		}
+		delete(localFiles, r.Name)
+
+		remoteContent, err := DownloadResource(conf, flowID, flowVersion, r.Name, r.ResourceType)
+		if err != nil {
+			return nil, err
+		}
+		local.ResourceType = r.ResourceType
+		local.Action = resourceSyncUnchanged
+		if sha256.Sum256(remoteContent) != sha256.Sum256(local.Content) {
+			local.Action = resourceSyncUpdate
+		}
+		plan = append(plan, local)
+	}
+
+	for _, local := range localFiles {
+		local.Action = resourceSyncCreate
+		plan = append(plan, local)
+	}
+
+	sort.SliceStable(plan, func(i, j int) bool { return plan[i].Name < plan[j].Name })
+
+	return plan, nil
+}
@@ -0,0 +1,258 @@
+package client_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestResourceTypeFromFileName(t *testing.T) {
+	testCases := []struct {
+		fileName string
+		expType  string
+		expError error
+	}{
+		{fileName: "script1.groovy", expType: "groovy"},
+		{fileName: "src/main/resources/script/Mapping.GROOVY", expType: "groovy"},
+		{fileName: "order.xsd", expType: "xsd"},
+		{fileName: "transform.xsl", expType: "xslt"},
+		{fileName: "service.wsdl", expType: "wsdl"},
+		{fileName: "README.md", expError: client.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			resourceType, err := client.ResourceTypeFromFileName(tc.fileName)
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if resourceType != tc.expType {
+				t.Errorf("Expected resource type %s, got %s", tc.expType, resourceType)
+			}
+		})
+	}
+}
+
+func TestDownloadResources(t *testing.T) {
+	listBody := `{"d": {"results": [
+		{"Name": "script1.groovy", "ResourceType": "groovy", "ResourceSize": "20", "ResourceSizeUnit": "Byte"},
+		{"Name": "order.xsd", "ResourceType": "xsd", "ResourceSize": 8, "ResourceSizeUnit": "Byte"}
+	]}}`
+	contents := map[string]string{
+		"script1.groovy": "println 'hello world'",
+		"order.xsd":      "<schema/>",
+	}
+
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasSuffix(r.URL.Path, "/Resources") {
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintln(w, listBody)
+				return
+			}
+			for name, content := range contents {
+				if strings.Contains(r.URL.Path, "Name='"+name+"'") {
+					body := map[string]map[string]string{"d": {"Name": name, "ResourceContent": encodeBase64(content)}}
+					w.WriteHeader(http.StatusOK)
+					json.NewEncoder(w).Encode(body)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	resp, err := client.GetResources(conf, "PurchaseOrder", "active")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	var table bytes.Buffer
+	resp.Print(&table)
+	if !strings.Contains(table.String(), "20 Byte") {
+		t.Errorf("Expected resource size in output, got:\n%s", table.String())
+	}
+
+	targetDir := t.TempDir()
+	var out bytes.Buffer
+	err = client.DownloadResources(&out, conf, "PurchaseOrder", "active", "", "", targetDir)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	for name, content := range contents {
+		b, err := os.ReadFile(filepath.Join(targetDir, name))
+		if err != nil {
+			t.Fatalf("Expected file %s, got error %q", name, err)
+		}
+		if string(b) != content {
+			t.Errorf("Expected content %q, got %q", content, b)
+		}
+	}
+
+	err = client.DownloadResources(&out, conf, "PurchaseOrder", "active", "missing.groovy", "", targetDir)
+	if !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("Expected error %q, got %q.", client.ErrNotFound, err)
+	}
+}
+
+func TestResourceCreate(t *testing.T) {
+	var requestBody map[string]string
+	var requestPath string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "POST" {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			requestPath = r.URL.Path
+			json.NewDecoder(r.Body).Decode(&requestBody)
+			w.WriteHeader(http.StatusCreated)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	resourceFile := filepath.Join(t.TempDir(), "order.xsd")
+	if err := os.WriteFile(resourceFile, []byte("<schema/>"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	err := client.ResourceCreate(&out, conf, "PurchaseOrder", "active", "", "", resourceFile)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if !strings.HasSuffix(requestPath, "/Resources") {
+		t.Errorf("Expected POST to Resources, got %s", requestPath)
+	}
+	if requestBody["Name"] != "order.xsd" || requestBody["ResourceType"] != "xsd" {
+		t.Errorf("Expected name order.xsd and type xsd, got %v", requestBody)
+	}
+	if requestBody["ResourceContent"] != encodeBase64("<schema/>") {
+		t.Errorf("Expected encoded content, got %s", requestBody["ResourceContent"])
+	}
+}
+
+func TestSyncResources(t *testing.T) {
+	listBody := `{"d": {"results": [
+		{"Name": "same.groovy", "ResourceType": "groovy"},
+		{"Name": "changed.groovy", "ResourceType": "groovy"},
+		{"Name": "orphan.groovy", "ResourceType": "groovy"}
+	]}}`
+	remote := map[string]string{
+		"same.groovy":    "println 'same'",
+		"changed.groovy": "println 'old'",
+		"orphan.groovy":  "println 'orphan'",
+	}
+
+	dir := t.TempDir()
+	local := map[string]string{
+		"same.groovy":    "println 'same'",
+		"changed.groovy": "println 'new'",
+		"new.xsd":        "<schema/>",
+		"notes.txt":      "not a resource",
+	}
+	for name, content := range local {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		deleteOrphans bool
+		dryRun        bool
+		expOut        []string
+		expRequests   []string
+	}{
+		{
+			name:        "dryRun",
+			dryRun:      true,
+			expOut:      []string{"create: 1, update: 1, delete: 0, unchanged: 1, orphan: 1", "Dry run"},
+			expRequests: nil,
+		},
+		{
+			name:        "keepOrphans",
+			expOut:      []string{"create: 1, update: 1, delete: 0, unchanged: 1, orphan: 1"},
+			expRequests: []string{"PUT changed.groovy", "POST Resources"},
+		},
+		{
+			name:          "deleteOrphans",
+			deleteOrphans: true,
+			expOut:        []string{"create: 1, update: 1, delete: 1, unchanged: 1, orphan: 0"},
+			expRequests:   []string{"PUT changed.groovy", "POST Resources", "DELETE orphan.groovy"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests []string
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					switch r.Method {
+					case "POST":
+						requests = append(requests, "POST Resources")
+						w.WriteHeader(http.StatusCreated)
+						return
+					case "PUT", "DELETE":
+						for name := range remote {
+							if strings.Contains(r.URL.Path, "Name='"+name+"'") {
+								requests = append(requests, r.Method+" "+name)
+							}
+						}
+						w.WriteHeader(http.StatusOK)
+						return
+					}
+					if strings.HasSuffix(r.URL.Path, "/Resources") {
+						w.WriteHeader(http.StatusOK)
+						fmt.Fprintln(w, listBody)
+						return
+					}
+					for name, content := range remote {
+						if strings.Contains(r.URL.Path, "Name='"+name+"'") {
+							body := map[string]map[string]string{"d": {"Name": name, "ResourceContent": encodeBase64(content)}}
+							w.WriteHeader(http.StatusOK)
+							json.NewEncoder(w).Encode(body)
+							return
+						}
+					}
+					w.WriteHeader(http.StatusOK)
+				})
+			defer cleanup()
+
+			conf := getTestConfiguration()
+			conf.ApiURL = url
+
+			var out bytes.Buffer
+			err := client.SyncResources(&out, conf, "PurchaseOrder", "active", dir, tc.deleteOrphans, tc.dryRun)
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			for _, exp := range tc.expOut {
+				if !strings.Contains(out.String(), exp) {
+					t.Errorf("Expected output to contain %q, got:\n%s", exp, out.String())
+				}
+			}
+			if strings.Join(requests, ",") != strings.Join(tc.expRequests, ",") {
+				t.Errorf("Expected requests %v, got %v", tc.expRequests, requests)
+			}
+		})
+	}
+}
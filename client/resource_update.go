@@ -24,24 +24,34 @@ func RunResourceUpdate(out io.Writer, conf config.Configuration, flowID string,
 
 //ResourceUpdate - update resource of the integration flow
 func ResourceUpdate(out io.Writer, conf config.Configuration, flowID string, flowVersion string, resourceName string, resourceType string, resourceFileName string) error {
-	csrfToken, cookies, err := getCsrfTokenAndCookies(conf)
-	if err != nil {
-		return err
-	}
-
 	if resourceName == "" {
 		resourceName = filepath.Base(resourceFileName)
 	}
 
-	var encodedContent string
+	if resourceType == "" {
+		var err error
+		resourceType, err = ResourceTypeFromFileName(resourceFileName)
+		if err != nil {
+			return err
+		}
+	}
+
 	contentData, err := ioutil.ReadFile(resourceFileName)
 	if err != nil {
 		return err
 	}
-	encodedContent = base64.StdEncoding.EncodeToString(contentData)
+
+	return updateResource(out, conf, flowID, flowVersion, resourceName, resourceType, contentData)
+}
+
+func updateResource(out io.Writer, conf config.Configuration, flowID string, flowVersion string, resourceName string, resourceType string, contentData []byte) error {
+	csrfToken, cookies, err := getCsrfTokenAndCookies(conf)
+	if err != nil {
+		return err
+	}
 
 	requestBody := map[string]string{
-		"ResourceContent": encodedContent,
+		"ResourceContent": base64.StdEncoding.EncodeToString(contentData),
 	}
 
 	requestBodyJSON, err := json.Marshal(requestBody)
@@ -49,8 +59,7 @@ func ResourceUpdate(out io.Writer, conf config.Configuration, flowID string, flo
 		return err
 	}
 
-	updateResourceURL := conf.ApiURL + "/IntegrationDesigntimeArtifacts(Id='" + flowID + "',Version='" + flowVersion + "')" +
-		"/$links/Resources(Name='" + resourceName + "',ResourceType='" + resourceType + "')"
+	updateResourceURL := resourceURL(conf, flowID, flowVersion, resourceName, resourceType)
 	log.Println("PUT ", updateResourceURL)
 
 	request, err := http.NewRequest("PUT", updateResourceURL, bytes.NewBuffer(requestBodyJSON))
@@ -69,17 +78,14 @@ func ResourceUpdate(out io.Writer, conf config.Configuration, flowID string, flo
 
 	response, err := httpClient.Do(request)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: %s", ErrConnection, err)
 	}
 	defer response.Body.Close()
 
-	body, _ := ioutil.ReadAll(response.Body)
-
-	statusOk := response.StatusCode >= 200 && response.StatusCode < 300
-	if !statusOk {
-		return fmt.Errorf("response Status: %s, response body: %s", response.Status, string(body))
+	if err := checkResponseStatus(response); err != nil {
+		return err
 	}
 
+	fmt.Fprintf(out, "Resource: %s (%s) updated\n", resourceName, resourceType)
 	return nil
-
 }
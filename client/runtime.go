@@ -0,0 +1,70 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//LogLevels - log levels of the deployed integration flow
+var LogLevels = []string{"info", "debug", "trace"}
+
+func runtimeLogConfigurationURL(conf config.Configuration, flowID string) string {
+	return conf.ApiURL + "/IntegrationRuntimeArtifacts(" + odataKey(flowID) + ")/LogConfiguration"
+}
+
+//GetRuntimeArtifact - get the deployed artifact, ErrNotFound is returned when the artifact is not deployed
+func GetRuntimeArtifact(conf config.Configuration, id string) (*model.RuntimeArtifactByIdResponse, error) {
+	var decodedRes model.RuntimeArtifactByIdResponse
+	if err := getEntity(conf, conf.ApiURL+"/IntegrationRuntimeArtifacts("+odataKey(id)+")", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunRuntimeLogLevel - set the log level of the deployed integration flow, the current log configuration is printed when level is empty
+func RunRuntimeLogLevel(out io.Writer, conf config.Configuration, flowID string, level string) {
+	if level == "" {
+		resp, err := GetRuntimeLogConfiguration(conf, flowID)
+		if err != nil {
+			log.Fatal("Error in GetRuntimeLogConfiguration:\n", err)
+		}
+		resp.Print(out)
+		return
+	}
+
+	err := SetRuntimeLogLevel(out, conf, flowID, level)
+	if err != nil {
+		log.Fatal("Error in SetRuntimeLogLevel:\n", err)
+	}
+}
+
+//GetRuntimeLogConfiguration - get the log configuration of the deployed integration flow
+func GetRuntimeLogConfiguration(conf config.Configuration, flowID string) (*model.RuntimeLogConfigurationResponse, error) {
+	var decodedRes model.RuntimeLogConfigurationResponse
+	if err := getEntity(conf, runtimeLogConfigurationURL(conf, flowID), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//SetRuntimeLogLevel - set the log level of the deployed integration flow, the trace level is reset by the tenant after 10 minutes
+func SetRuntimeLogLevel(out io.Writer, conf config.Configuration, flowID string, level string) error {
+	level = strings.ToLower(level)
+	if !containsString(LogLevels, level) {
+		return fmt.Errorf("%w: log level %s, available values: %s", ErrInvalid, level, strings.Join(LogLevels, ", "))
+	}
+
+	requestBody := map[string]string{
+		"LogLevel": strings.ToUpper(level),
+	}
+	if err := sendEntity(conf, "PUT", runtimeLogConfigurationURL(conf, flowID), requestBody, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Log level of the integration flow: %s set to %s\n", flowID, level)
+	return nil
+}
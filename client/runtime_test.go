@@ -0,0 +1,100 @@
+package client_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestGetRuntimeArtifact(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/IntegrationRuntimeArtifacts('MyFlow')" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"d": {"Id": "MyFlow", "Version": "1.0.2", "Name": "My Flow", "Type": "INTEGRATION_FLOW", "DeployedBy": "user", "DeployedOn": "/Date(1665000000000)/", "Status": "STARTED"}}`)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	resp, err := client.GetRuntimeArtifact(conf, "MyFlow")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if a := resp.D; a.Id != "MyFlow" || a.Version != "1.0.2" || a.Status != "STARTED" || a.DeployedOn.String() == "" {
+		t.Errorf("Unexpected runtime artifact %+v", a)
+	}
+
+	_, err = client.GetRuntimeArtifact(conf, "NotDeployed")
+	if !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("Expected error %q, got %q.", client.ErrNotFound, err)
+	}
+}
+
+func TestSetRuntimeLogLevel(t *testing.T) {
+	testCases := []struct {
+		name     string
+		level    string
+		expBody  string
+		expOut   string
+		expError error
+	}{
+		{name: "debug", level: "debug", expBody: "DEBUG", expOut: "Log level of the integration flow: MyFlow set to debug\n"},
+		{name: "upperCase", level: "TRACE", expBody: "TRACE", expOut: "Log level of the integration flow: MyFlow set to trace\n"},
+		{name: "unknown", level: "verbose", expError: client.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var method, path string
+			var body map[string]string
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == http.MethodPut {
+						method = r.Method
+						path = r.URL.Path
+						json.NewDecoder(r.Body).Decode(&body)
+					}
+					w.WriteHeader(http.StatusOK)
+				})
+			defer cleanup()
+
+			conf := getTestConfiguration()
+			conf.ApiURL = url
+
+			var out bytes.Buffer
+			err := client.SetRuntimeLogLevel(&out, conf, "MyFlow", tc.level)
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				if method != "" {
+					t.Errorf("Expected no request, got %s %s", method, path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			expPath := "/IntegrationRuntimeArtifacts('MyFlow')/LogConfiguration"
+			if method != http.MethodPut || path != expPath {
+				t.Errorf("Expected PUT %s, got %s %s", expPath, method, path)
+			}
+			if body["LogLevel"] != tc.expBody {
+				t.Errorf("Expected LogLevel %q, got %q", tc.expBody, body["LogLevel"])
+			}
+			if out.String() != tc.expOut {
+				t.Errorf("Expected output %q, got %q", tc.expOut, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/iflow"
+	"github.com/tobiaszgithub/cig/scripttest"
+)
+
+//RunTestScripts - call the function TestScripts and print the results. The program exits with ErrTestFailed
+//when any of the fixtures failed.
+func RunTestScripts(out io.Writer, conf config.Configuration, source string, fixturesDir string, groovy string) {
+	results, err := TestScripts(conf, source, fixturesDir, groovy)
+	if err != nil {
+		log.Fatal("Error in TestScripts:\n", err)
+	}
+
+	failed := PrintScriptTestResults(out, results)
+	if failed > 0 {
+		log.Fatal(fmt.Errorf("%w: %d of %d script tests failed", ErrTestFailed, failed, len(results)))
+	}
+}
+
+//TestScripts runs the Groovy scripts of the flow with the fixtures of the directory. The source is the directory created
+//by flow pull, the zip file or the id of the flow in the tenant. The script of the fixture is the name of the file
+//or the path in src/main/resources, e.g. script/script1.groovy.
+func TestScripts(conf config.Configuration, source string, fixturesDir string, groovy string) ([]scripttest.Result, error) {
+	fixtures, err := scripttest.ReadFixtures(fixturesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	flow, err := loadFlow(conf, source)
+	if err != nil {
+		return nil, err
+	}
+	scripts := flowScripts(flow)
+
+	runner, err := scripttest.NewRunner(groovy)
+	if err != nil {
+		return nil, err
+	}
+	defer runner.Close()
+
+	var results []scripttest.Result
+	for _, f := range fixtures {
+		script, ok := scripts[strings.TrimPrefix(f.Script, iflow.ResourcesDir)]
+		if !ok {
+			results = append(results, scripttest.Result{Fixture: f,
+				Err: fmt.Errorf("%w: script %s in the integration flow %s", ErrNotFound, f.Script, flow.ID())})
+			continue
+		}
+		results = append(results, runner.Test(f, script))
+	}
+	return results, nil
+}
+
+//PrintScriptTestResults prints the results and returns the number of the failed fixtures
+func PrintScriptTestResults(out io.Writer, results []scripttest.Result) int {
+	failed := 0
+	for _, r := range results {
+		if r.Passed() {
+			fmt.Fprintf(out, "PASS %s (%s)\n", r.Fixture.Name, r.Fixture.Script)
+			continue
+		}
+		failed++
+		fmt.Fprintf(out, "FAIL %s (%s)\n", r.Fixture.Name, r.Fixture.Script)
+		if r.Err != nil {
+			fmt.Fprintf(out, "\t%s\n", strings.ReplaceAll(r.Err.Error(), "\n", "\n\t"))
+		}
+		for _, d := range r.Differences {
+			fmt.Fprintf(out, "\t%s\n", d)
+		}
+	}
+	fmt.Fprintf(out, "Script tests: %d, passed: %d, failed: %d\n", len(results), len(results)-failed, failed)
+	return failed
+}
+
+//flowScripts returns the Groovy scripts of the flow by the name of the file and by the path in src/main/resources
+func flowScripts(flow *iflow.Flow) map[string][]byte {
+	scripts := map[string][]byte{}
+	for _, r := range flow.Resources() {
+		ext := strings.ToLower(path.Ext(r.Name))
+		if ext != ".groovy" && ext != ".gsh" {
+			continue
+		}
+		scripts[strings.TrimPrefix(r.Name, iflow.ResourcesDir)] = r.Content
+		if _, ok := scripts[path.Base(r.Name)]; !ok {
+			scripts[path.Base(r.Name)] = r.Content
+		}
+	}
+	return scripts
+}
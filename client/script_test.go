@@ -0,0 +1,71 @@
+package client_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestTestScripts(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake groovy is a shell script")
+	}
+	dir := t.TempDir()
+	groovy := filepath.Join(dir, "groovy")
+	os.WriteFile(groovy, []byte("#!/bin/sh\ncp \"$6\" \"$7\"\n"), 0777)
+
+	flowDir := filepath.Join(dir, "PurchaseOrder")
+	files := map[string]string{
+		"META-INF/MANIFEST.MF":                   "Manifest-Version: 1.0\r\nBundle-SymbolicName: PurchaseOrder\r\n\r\n",
+		"src/main/resources/script/echo.groovy":  "def Message processData(Message message) { return message }\n",
+		"src/main/resources/mapping/Order.mmap":  "<mapping/>",
+		"src/main/resources/script/other.groovy": "def Message processData(Message message) { return message }\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(flowDir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(path), os.ModePerm)
+		os.WriteFile(path, []byte(content), 0666)
+	}
+
+	fixturesDir := filepath.Join(dir, "tests")
+	os.MkdirAll(fixturesDir, os.ModePerm)
+	fixtures := map[string]string{
+		"1-pass.json":    `{"script": "echo.groovy", "input": {"body": "<order/>"}, "expected": {"body": "<order/>"}}`,
+		"2-fail.json":    `{"script": "script/other.groovy", "input": {"body": "<order/>"}, "expected": {"headers": {"OrderType": "standard"}}}`,
+		"3-missing.json": `{"script": "src/main/resources/script/missing.groovy", "input": {}, "expected": {}}`,
+	}
+	for name, content := range fixtures {
+		os.WriteFile(filepath.Join(fixturesDir, name), []byte(content), 0666)
+	}
+
+	results, err := client.TestScripts(getTestConfiguration(), flowDir, fixturesDir, groovy)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if len(results) != 3 || !results[0].Passed() || results[1].Passed() || !errors.Is(results[2].Err, client.ErrNotFound) {
+		t.Fatalf("Unexpected results %+v", results)
+	}
+
+	var out bytes.Buffer
+	if failed := client.PrintScriptTestResults(&out, results); failed != 2 {
+		t.Errorf("Expected 2 failed, got %d", failed)
+	}
+	exp := []string{
+		"PASS 1-pass (echo.groovy)",
+		"FAIL 2-fail (script/other.groovy)",
+		"\theader OrderType: expected \"standard\", not set",
+		"FAIL 3-missing (src/main/resources/script/missing.groovy)",
+		"Script tests: 3, passed: 1, failed: 2",
+	}
+	for _, line := range exp {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("Expected line %q in output:\n%s", line, out.String())
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+//ReadSecret returns the secret value for the name. When secretsFileName is set, the value is taken
+//from the secrets file (lines name=value, # starts a comment), otherwise the whole stdin is read.
+//The secret is never written to the output or to the log.
+func ReadSecret(stdin io.Reader, secretsFileName string, name string) (string, error) {
+	if secretsFileName != "" {
+		secrets, err := readSecretsFile(secretsFileName)
+		if err != nil {
+			return "", err
+		}
+		secret, ok := secrets[name]
+		if !ok {
+			return "", fmt.Errorf("%w: secret for %s not found in file %s", ErrInvalid, name, secretsFileName)
+		}
+		return secret, nil
+	}
+
+	b, err := io.ReadAll(stdin)
+	if err != nil {
+		return "", err
+	}
+	secret := strings.TrimRight(string(b), "\r\n")
+	if secret == "" {
+		return "", fmt.Errorf("%w: empty secret read from stdin", ErrInvalid)
+	}
+	return secret, nil
+}
+
+func readSecretsFile(secretsFileName string) (map[string]string, error) {
+	f, err := os.Open(secretsFileName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parseSecrets(f)
+}
+
+func parseSecrets(r io.Reader) (map[string]string, error) {
+	secrets := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("%w: secrets file line %d is not in format name=value", ErrInvalid, lineNumber)
+		}
+		secrets[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return secrets, nil
+}
@@ -0,0 +1,336 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+const (
+	//CredentialTypeUser - UserCredentials security material
+	CredentialTypeUser = "user"
+	//CredentialTypeOAuth2 - OAuth2ClientCredentials security material
+	CredentialTypeOAuth2 = "oauth2"
+)
+
+func credentialEntitySet(credentialType string) (string, error) {
+	switch credentialType {
+	case CredentialTypeUser:
+		return "UserCredentials", nil
+	case CredentialTypeOAuth2:
+		return "OAuth2ClientCredentials", nil
+	}
+	return "", fmt.Errorf("%w: unknown credential type %s, available values: %s, %s", ErrInvalid, credentialType, CredentialTypeUser, CredentialTypeOAuth2)
+}
+
+func credentialURL(conf config.Configuration, credentialType string, name string) (string, error) {
+	entitySet, err := credentialEntitySet(credentialType)
+	if err != nil {
+		return "", err
+	}
+	if name == "" {
+		return conf.ApiURL + "/" + entitySet, nil
+	}
+	return conf.ApiURL + "/" + entitySet + "(" + odataKey(name) + ")", nil
+}
+
+//RunGetCredentials - call the functions GetUserCredentials and GetOAuth2ClientCredentials
+func RunGetCredentials(out io.Writer, conf config.Configuration, credentialType string) {
+	var users *model.UserCredentialsResponse
+	var oauth2 *model.OAuth2ClientCredentialsResponse
+	var err error
+
+	if credentialType == "" || credentialType == CredentialTypeUser {
+		users, err = GetUserCredentials(conf)
+		if err != nil {
+			log.Fatal("Error in GetUserCredentials:\n", err)
+		}
+	}
+	if credentialType == "" || credentialType == CredentialTypeOAuth2 {
+		oauth2, err = GetOAuth2ClientCredentials(conf)
+		if err != nil {
+			log.Fatal("Error in GetOAuth2ClientCredentials:\n", err)
+		}
+	}
+	if users == nil && oauth2 == nil {
+		_, err = credentialEntitySet(credentialType)
+		log.Fatal(err)
+	}
+
+	model.PrintCredentials(out, users, oauth2)
+}
+
+//GetUserCredentials - get list of the deployed user credentials
+func GetUserCredentials(conf config.Configuration) (*model.UserCredentialsResponse, error) {
+	var decodedRes model.UserCredentialsResponse
+	if err := getEntity(conf, conf.ApiURL+"/UserCredentials", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//GetOAuth2ClientCredentials - get list of the deployed OAuth2 client credentials
+func GetOAuth2ClientCredentials(conf config.Configuration) (*model.OAuth2ClientCredentialsResponse, error) {
+	var decodedRes model.OAuth2ClientCredentialsResponse
+	if err := getEntity(conf, conf.ApiURL+"/OAuth2ClientCredentials", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunInspectCredential - call the function InspectUserCredential or InspectOAuth2ClientCredential.
+//When the credential type is empty, user credentials are searched first.
+func RunInspectCredential(out io.Writer, conf config.Configuration, credentialType string, name string) {
+	if credentialType == "" {
+		var err error
+		credentialType, err = FindCredentialType(conf, name)
+		if err != nil {
+			log.Fatal("Error in InspectCredential:\n", err)
+		}
+	}
+
+	switch credentialType {
+	case CredentialTypeOAuth2:
+		resp, err := InspectOAuth2ClientCredential(conf, name)
+		if err != nil {
+			log.Fatal("Error in InspectOAuth2ClientCredential:\n", err)
+		}
+		resp.Print(out)
+	default:
+		resp, err := InspectUserCredential(conf, name)
+		if err != nil {
+			log.Fatal("Error in InspectUserCredential:\n", err)
+		}
+		resp.Print(out)
+	}
+}
+
+//InspectUserCredential - get user credential by name
+func InspectUserCredential(conf config.Configuration, name string) (*model.UserCredentialByIdResponse, error) {
+	entityURL, _ := credentialURL(conf, CredentialTypeUser, name)
+	var decodedRes model.UserCredentialByIdResponse
+	if err := getEntity(conf, entityURL, &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//InspectOAuth2ClientCredential - get OAuth2 client credential by name
+func InspectOAuth2ClientCredential(conf config.Configuration, name string) (*model.OAuth2ClientCredentialByIdResponse, error) {
+	entityURL, _ := credentialURL(conf, CredentialTypeOAuth2, name)
+	var decodedRes model.OAuth2ClientCredentialByIdResponse
+	if err := getEntity(conf, entityURL, &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//FindCredentialType returns the type of the deployed credential with the name
+func FindCredentialType(conf config.Configuration, name string) (string, error) {
+	_, err := InspectUserCredential(conf, name)
+	if err == nil {
+		return CredentialTypeUser, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return "", err
+	}
+
+	_, err = InspectOAuth2ClientCredential(conf, name)
+	if err == nil {
+		return CredentialTypeOAuth2, nil
+	}
+	if errors.Is(err, ErrNotFound) {
+		return "", fmt.Errorf("%w: credential %s", ErrNotFound, name)
+	}
+	return "", err
+}
+
+//RunCreateUserCredential - call the function CreateUserCredential
+func RunCreateUserCredential(out io.Writer, conf config.Configuration, credential model.UserCredential) {
+	err := CreateUserCredential(out, conf, credential)
+	if err != nil {
+		log.Fatal("Error in CreateUserCredential:\n", err)
+	}
+}
+
+//CreateUserCredential - deploy new user credential
+func CreateUserCredential(out io.Writer, conf config.Configuration, credential model.UserCredential) error {
+	entityURL, _ := credentialURL(conf, CredentialTypeUser, "")
+	if err := sendEntity(conf, "POST", entityURL, userCredentialRequestBody(credential), nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "User credential: %s created\n", credential.Name)
+	return nil
+}
+
+//RunUpdateUserCredential - call the function UpdateUserCredential
+func RunUpdateUserCredential(out io.Writer, conf config.Configuration, credential model.UserCredential) {
+	err := UpdateUserCredential(out, conf, credential)
+	if err != nil {
+		log.Fatal("Error in UpdateUserCredential:\n", err)
+	}
+}
+
+//UpdateUserCredential - update deployed user credential, empty fields keep the current values
+func UpdateUserCredential(out io.Writer, conf config.Configuration, credential model.UserCredential) error {
+	current, err := InspectUserCredential(conf, credential.Name)
+	if err != nil {
+		return err
+	}
+	updated := current.D
+	if credential.Kind != "" {
+		updated.Kind = credential.Kind
+	}
+	if credential.Description != "" {
+		updated.Description = credential.Description
+	}
+	if credential.User != "" {
+		updated.User = credential.User
+	}
+	if credential.CompanyId != "" {
+		updated.CompanyId = credential.CompanyId
+	}
+	updated.Password = credential.Password
+
+	entityURL, _ := credentialURL(conf, CredentialTypeUser, credential.Name)
+	if err := sendEntity(conf, "PUT", entityURL, userCredentialRequestBody(updated), nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "User credential: %s updated\n", credential.Name)
+	return nil
+}
+
+func userCredentialRequestBody(c model.UserCredential) map[string]string {
+	kind := c.Kind
+	if kind == "" {
+		kind = "default"
+	}
+	requestBody := map[string]string{
+		"Name":        c.Name,
+		"Kind":        kind,
+		"Description": c.Description,
+		"User":        c.User,
+		"CompanyId":   c.CompanyId,
+	}
+	if c.Password != "" {
+		requestBody["Password"] = c.Password
+	}
+	return requestBody
+}
+
+//RunCreateOAuth2ClientCredential - call the function CreateOAuth2ClientCredential
+func RunCreateOAuth2ClientCredential(out io.Writer, conf config.Configuration, credential model.OAuth2ClientCredential) {
+	err := CreateOAuth2ClientCredential(out, conf, credential)
+	if err != nil {
+		log.Fatal("Error in CreateOAuth2ClientCredential:\n", err)
+	}
+}
+
+//CreateOAuth2ClientCredential - deploy new OAuth2 client credential
+func CreateOAuth2ClientCredential(out io.Writer, conf config.Configuration, credential model.OAuth2ClientCredential) error {
+	entityURL, _ := credentialURL(conf, CredentialTypeOAuth2, "")
+	if err := sendEntity(conf, "POST", entityURL, oauth2ClientCredentialRequestBody(credential), nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "OAuth2 client credential: %s created\n", credential.Name)
+	return nil
+}
+
+//RunUpdateOAuth2ClientCredential - call the function UpdateOAuth2ClientCredential
+func RunUpdateOAuth2ClientCredential(out io.Writer, conf config.Configuration, credential model.OAuth2ClientCredential) {
+	err := UpdateOAuth2ClientCredential(out, conf, credential)
+	if err != nil {
+		log.Fatal("Error in UpdateOAuth2ClientCredential:\n", err)
+	}
+}
+
+//UpdateOAuth2ClientCredential - update deployed OAuth2 client credential, empty fields keep the current values
+func UpdateOAuth2ClientCredential(out io.Writer, conf config.Configuration, credential model.OAuth2ClientCredential) error {
+	current, err := InspectOAuth2ClientCredential(conf, credential.Name)
+	if err != nil {
+		return err
+	}
+	updated := current.D
+	for _, f := range []struct {
+		value  string
+		target *string
+	}{
+		{credential.Description, &updated.Description},
+		{credential.TokenServiceUrl, &updated.TokenServiceUrl},
+		{credential.ClientId, &updated.ClientId},
+		{credential.ClientAuthentication, &updated.ClientAuthentication},
+		{credential.Scope, &updated.Scope},
+		{credential.ScopeContentType, &updated.ScopeContentType},
+		{credential.Resource, &updated.Resource},
+		{credential.Audience, &updated.Audience},
+	} {
+		if f.value != "" {
+			*f.target = f.value
+		}
+	}
+	updated.ClientSecret = credential.ClientSecret
+
+	entityURL, _ := credentialURL(conf, CredentialTypeOAuth2, credential.Name)
+	if err := sendEntity(conf, "PUT", entityURL, oauth2ClientCredentialRequestBody(updated), nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "OAuth2 client credential: %s updated\n", credential.Name)
+	return nil
+}
+
+func oauth2ClientCredentialRequestBody(c model.OAuth2ClientCredential) map[string]string {
+	requestBody := map[string]string{
+		"Name":                 c.Name,
+		"Description":          c.Description,
+		"TokenServiceUrl":      c.TokenServiceUrl,
+		"ClientId":             c.ClientId,
+		"ClientAuthentication": c.ClientAuthentication,
+		"Scope":                c.Scope,
+		"ScopeContentType":     c.ScopeContentType,
+		"Resource":             c.Resource,
+		"Audience":             c.Audience,
+	}
+	if requestBody["ClientAuthentication"] == "" {
+		requestBody["ClientAuthentication"] = "Send as Request Header"
+	}
+	if requestBody["ScopeContentType"] == "" {
+		requestBody["ScopeContentType"] = "application/x-www-form-urlencoded"
+	}
+	if c.ClientSecret != "" {
+		requestBody["ClientSecret"] = c.ClientSecret
+	}
+	return requestBody
+}
+
+//RunDeleteCredential - call the function DeleteCredential
+func RunDeleteCredential(out io.Writer, conf config.Configuration, credentialType string, name string) {
+	err := DeleteCredential(out, conf, credentialType, name)
+	if err != nil {
+		log.Fatal("Error in DeleteCredential:\n", err)
+	}
+}
+
+//DeleteCredential - delete deployed credential, when the type is empty it is looked up by name
+func DeleteCredential(out io.Writer, conf config.Configuration, credentialType string, name string) error {
+	if credentialType == "" {
+		var err error
+		credentialType, err = FindCredentialType(conf, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	entityURL, err := credentialURL(conf, credentialType, name)
+	if err != nil {
+		return err
+	}
+	if err := sendEntity(conf, "DELETE", entityURL, nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Credential: %s (%s) deleted\n", name, credentialType)
+	return nil
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func secureParameterURL(conf config.Configuration, name string) string {
+	if name == "" {
+		return conf.ApiURL + "/SecureParameters"
+	}
+	return conf.ApiURL + "/SecureParameters(" + odataKey(name) + ")"
+}
+
+//RunGetSecureParameters - call the function GetSecureParameters
+func RunGetSecureParameters(out io.Writer, conf config.Configuration) {
+	resp, err := GetSecureParameters(conf)
+	if err != nil {
+		log.Fatal("Error in GetSecureParameters:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetSecureParameters - get list of the deployed secure parameters
+func GetSecureParameters(conf config.Configuration) (*model.SecureParametersResponse, error) {
+	var decodedRes model.SecureParametersResponse
+	if err := getEntity(conf, secureParameterURL(conf, ""), &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//RunCreateSecureParameter - call the function CreateSecureParameter
+func RunCreateSecureParameter(out io.Writer, conf config.Configuration, name string, description string, value string) {
+	err := CreateSecureParameter(out, conf, name, description, value)
+	if err != nil {
+		log.Fatal("Error in CreateSecureParameter:\n", err)
+	}
+}
+
+//CreateSecureParameter - deploy new secure parameter
+func CreateSecureParameter(out io.Writer, conf config.Configuration, name string, description string, value string) error {
+	requestBody := map[string]string{
+		"Name":        name,
+		"Description": description,
+		"SecureParam": value,
+	}
+	if err := sendEntity(conf, "POST", secureParameterURL(conf, ""), requestBody, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Secure parameter: %s created\n", name)
+	return nil
+}
+
+//RunUpdateSecureParameter - call the function UpdateSecureParameter
+func RunUpdateSecureParameter(out io.Writer, conf config.Configuration, name string, description string, value string) {
+	err := UpdateSecureParameter(out, conf, name, description, value)
+	if err != nil {
+		log.Fatal("Error in UpdateSecureParameter:\n", err)
+	}
+}
+
+//UpdateSecureParameter - update deployed secure parameter, empty description keeps the current one
+func UpdateSecureParameter(out io.Writer, conf config.Configuration, name string, description string, value string) error {
+	if description == "" {
+		var current model.SecureParameterByIdResponse
+		if err := getEntity(conf, secureParameterURL(conf, name), &current); err != nil {
+			return err
+		}
+		description = current.D.Description
+	}
+
+	requestBody := map[string]string{
+		"Name":        name,
+		"Description": description,
+		"SecureParam": value,
+	}
+	if err := sendEntity(conf, "PUT", secureParameterURL(conf, name), requestBody, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Secure parameter: %s updated\n", name)
+	return nil
+}
+
+//RunDeleteSecureParameter - call the function DeleteSecureParameter
+func RunDeleteSecureParameter(out io.Writer, conf config.Configuration, name string) {
+	err := DeleteSecureParameter(out, conf, name)
+	if err != nil {
+		log.Fatal("Error in DeleteSecureParameter:\n", err)
+	}
+}
+
+//DeleteSecureParameter - delete deployed secure parameter
+func DeleteSecureParameter(out io.Writer, conf config.Configuration, name string) error {
+	if err := sendEntity(conf, "DELETE", secureParameterURL(conf, name), nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Secure parameter: %s deleted\n", name)
+	return nil
+}
@@ -0,0 +1,250 @@
+package client_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func TestReadSecret(t *testing.T) {
+	secretsFile := filepath.Join(t.TempDir(), "secrets.properties")
+	content := "# test secrets\nSFTP_User = s3cr3t=with=equals\n\nOAuth_Backend=client-secret\n"
+	if err := os.WriteFile(secretsFile, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name        string
+		stdin       string
+		secretsFile string
+		expSecret   string
+		expError    error
+	}{
+		{name: "stdin", stdin: "pa$$word\n", expSecret: "pa$$word"},
+		{name: "emptyStdin", stdin: "\n", expError: client.ErrInvalid},
+		{name: "SFTP_User", secretsFile: secretsFile, expSecret: "s3cr3t=with=equals"},
+		{name: "OAuth_Backend", secretsFile: secretsFile, expSecret: "client-secret"},
+		{name: "missing", secretsFile: secretsFile, expError: client.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret, err := client.ReadSecret(strings.NewReader(tc.stdin), tc.secretsFile, tc.name)
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if secret != tc.expSecret {
+				t.Errorf("Expected secret %q, got %q", tc.expSecret, secret)
+			}
+		})
+	}
+}
+
+func TestCreateUserCredential(t *testing.T) {
+	var requestBody map[string]string
+	var requestPath string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "POST" {
+				requestPath = r.URL.Path
+				json.NewDecoder(r.Body).Decode(&requestBody)
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	var out bytes.Buffer
+	credential := model.UserCredential{Name: "SFTP_User", User: "sftp", Password: "s3cr3t"}
+	err := client.CreateUserCredential(&out, conf, credential)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if requestPath != "/UserCredentials" {
+		t.Errorf("Expected POST to /UserCredentials, got %s", requestPath)
+	}
+	if requestBody["Password"] != "s3cr3t" || requestBody["Kind"] != "default" {
+		t.Errorf("Expected password and default kind in request, got Kind %q", requestBody["Kind"])
+	}
+	if strings.Contains(out.String(), "s3cr3t") {
+		t.Errorf("Output must not contain the secret, got %q", out.String())
+	}
+
+	resp := model.UserCredentialByIdResponse{D: credential}
+	out.Reset()
+	resp.Print(&out)
+	if strings.Contains(out.String(), "s3cr3t") {
+		t.Errorf("Printed credential must not contain the secret, got %q", out.String())
+	}
+}
+
+func TestGetUserCredentials(t *testing.T) {
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"d": {"results": [{"Name": "SFTP_User", "Kind": "default", "User": "sftp",
+				"SecurityArtifactDescriptor": {"Type": "DEFAULT", "DeployedBy": "admin", "DeployedOn": "/Date(1665396000000)/", "Status": "DEPLOYED"}}]}}`))
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	users, err := client.GetUserCredentials(conf)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	var out bytes.Buffer
+	model.PrintCredentials(&out, users, nil)
+	if !strings.Contains(out.String(), "2022-10-10 10:00:00") || strings.Contains(out.String(), "/Date(") {
+		t.Errorf("Expected formatted DeployedOn, got %q", out.String())
+	}
+}
+
+func TestDeleteCredential(t *testing.T) {
+	var deletePath string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == "DELETE":
+				deletePath = r.URL.Path
+				w.WriteHeader(http.StatusOK)
+			case strings.HasPrefix(r.URL.Path, "/UserCredentials("):
+				w.WriteHeader(http.StatusNotFound)
+			default:
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"d": {"Name": "OAuth_Backend"}}`))
+			}
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	var out bytes.Buffer
+	err := client.DeleteCredential(&out, conf, "", "OAuth_Backend")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if deletePath != "/OAuth2ClientCredentials('OAuth_Backend')" {
+		t.Errorf("Expected DELETE of OAuth2 client credential, got %s", deletePath)
+	}
+	if !strings.Contains(out.String(), "OAuth_Backend (oauth2) deleted") {
+		t.Errorf("Unexpected output %q", out.String())
+	}
+}
+
+func TestCreateSecureParameter(t *testing.T) {
+	var requestBody map[string]string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "POST" && r.URL.Path == "/SecureParameters" {
+				json.NewDecoder(r.Body).Decode(&requestBody)
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	var out bytes.Buffer
+	err := client.CreateSecureParameter(&out, conf, "SFTP_Passphrase", "passphrase", "s3cr3t")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if requestBody["Name"] != "SFTP_Passphrase" || requestBody["SecureParam"] != "s3cr3t" {
+		t.Errorf("Unexpected request body for %q", requestBody["Name"])
+	}
+	if strings.Contains(out.String(), "s3cr3t") {
+		t.Errorf("Output must not contain the secret, got %q", out.String())
+	}
+}
+
+func TestAppendKnownHosts(t *testing.T) {
+	current := "sftp.example.com ssh-rsa AAAAB3NzaC1yc2E\n"
+	testCases := []struct {
+		name       string
+		entries    string
+		notFound   bool
+		expOut     string
+		expContent string
+	}{
+		{
+			name:       "append",
+			entries:    "# ssh-keyscan\nsftp.example.com ssh-rsa AAAAB3NzaC1yc2E\nsftp2.example.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5\n",
+			expOut:     "+ sftp2.example.com ssh-ed25519\nadded: 1\n",
+			expContent: current + "sftp2.example.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5\n",
+		},
+		{
+			name:    "upToDate",
+			entries: "sftp.example.com ssh-rsa AAAAB3NzaC1yc2E\n",
+			expOut:  "Known hosts are up to date\n",
+		},
+		{
+			name:       "noKnownHosts",
+			entries:    "sftp2.example.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5\n",
+			notFound:   true,
+			expOut:     "+ sftp2.example.com ssh-ed25519\nadded: 1\n",
+			expContent: "sftp2.example.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var uploaded string
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == "PUT" {
+						b, _ := io.ReadAll(r.Body)
+						uploaded = string(b)
+					}
+					if tc.notFound && r.Method == "GET" && strings.Contains(r.URL.Path, "FileResources") {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+					if r.Method == "GET" {
+						io.WriteString(w, current)
+					}
+				})
+			defer cleanup()
+
+			conf := getTestConfiguration()
+			conf.ApiURL = url
+
+			var out bytes.Buffer
+			err := client.AppendKnownHosts(&out, conf, strings.NewReader(tc.entries))
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if out.String() != tc.expOut {
+				t.Errorf("Expected output %q, got %q", tc.expOut, out.String())
+			}
+			if uploaded != tc.expContent {
+				t.Errorf("Expected uploaded content %q, got %q", tc.expContent, uploaded)
+			}
+		})
+	}
+}
@@ -0,0 +1,503 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/iflow"
+	"github.com/tobiaszgithub/cig/model"
+	"gopkg.in/yaml.v3"
+)
+
+//StatePlan - changes of the tenant with the functions executing them, the actions are in the dependency order:
+//credentials, packages, integration flows, configurations and deployments
+type StatePlan struct {
+	model.StatePlan
+	apply []func(out io.Writer) error
+}
+
+type plannedAction struct {
+	action model.StateAction
+	apply  func(out io.Writer) error
+}
+
+//ReadStateDocument reads the state file in yaml or json format
+func ReadStateDocument(fileName string) (*model.StateDocument, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var doc model.StateDocument
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("%w: cannot parse state file: %s", ErrInvalid, err)
+	}
+	if err := validateStateDocument(&doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+//validateStateDocument checks the required fields: name and user of the credentials, id of the packages and flows
+func validateStateDocument(doc *model.StateDocument) error {
+	keys := make([]string, 0, len(doc.Tenants))
+	for k := range doc.Tenants {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		state := doc.Tenants[k]
+		for i, c := range state.Credentials {
+			if c.Name == "" {
+				return fmt.Errorf("%w: tenant %s: credential %d: name not set", ErrInvalid, k, i+1)
+			}
+			if c.User == "" {
+				return fmt.Errorf("%w: tenant %s: credential %s: user not set", ErrInvalid, k, c.Name)
+			}
+		}
+		for i, p := range state.Packages {
+			if p.ID == "" {
+				return fmt.Errorf("%w: tenant %s: package %d: id not set", ErrInvalid, k, i+1)
+			}
+			for j, f := range p.Flows {
+				if f.ID == "" {
+					return fmt.Errorf("%w: tenant %s: package %s: flow %d: id not set", ErrInvalid, k, p.ID, j+1)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+//RunPlanState - call the function PlanState and print the plan
+func RunPlanState(out io.Writer, conf config.Configuration, fileName string) {
+	plan, err := planStateFile(conf, fileName)
+	if err != nil {
+		log.Fatal("Error in PlanState:\n", err)
+	}
+	plan.Print(out)
+}
+
+//RunApplyState - call the functions PlanState and ApplyState and print the plan and the final report
+func RunApplyState(out io.Writer, conf config.Configuration, fileName string) {
+	plan, err := planStateFile(conf, fileName)
+	if err != nil {
+		log.Fatal("Error in PlanState:\n", err)
+	}
+	plan.Print(out)
+	if len(plan.Actions) == 0 {
+		return
+	}
+
+	fmt.Fprintln(out)
+	report, err := ApplyState(out, plan)
+	fmt.Fprintln(out)
+	report.Print(out)
+	if err != nil {
+		log.Fatal("Error in ApplyState:\n", err)
+	}
+}
+
+func planStateFile(conf config.Configuration, fileName string) (*StatePlan, error) {
+	doc, err := ReadStateDocument(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return PlanState(conf, doc, filepath.Dir(fileName))
+}
+
+//PlanState computes the changes needed to bring the tenant to the state defined for the tenant key of the configuration.
+//The sources of the integration flows are resolved against baseDir.
+func PlanState(conf config.Configuration, doc *model.StateDocument, baseDir string) (*StatePlan, error) {
+	state, ok := doc.Tenants[conf.Key]
+	if !ok {
+		return nil, fmt.Errorf("%w: tenant key %s not defined in the state file", ErrInvalid, conf.Key)
+	}
+
+	var credentials, packages, flows, configurations, deployments []plannedAction
+
+	for _, c := range state.Credentials {
+		a, err := planCredential(conf, c)
+		if err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, a...)
+	}
+
+	existingPackages, err := GetIntegrationPackages(conf)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range state.Packages {
+		packages = append(packages, planPackage(conf, p, existingPackages.D.Results)...)
+
+		for _, f := range p.Flows {
+			fp, err := planFlow(conf, p.ID, f, baseDir)
+			if err != nil {
+				return nil, err
+			}
+			flows = append(flows, fp.flow...)
+			configurations = append(configurations, fp.configurations...)
+			deployments = append(deployments, fp.deployment...)
+		}
+	}
+
+	plan := &StatePlan{}
+	for _, phase := range [][]plannedAction{credentials, packages, flows, configurations, deployments} {
+		for _, a := range phase {
+			plan.Actions = append(plan.Actions, a.action)
+			plan.apply = append(plan.apply, a.apply)
+		}
+	}
+	return plan, nil
+}
+
+//ApplyState executes the actions of the plan in order. The execution stops on the first error,
+//the following actions are reported as skipped.
+func ApplyState(out io.Writer, plan *StatePlan) (*model.StateReport, error) {
+	report := &model.StateReport{}
+	var failed error
+	for i, a := range plan.Actions {
+		result := model.StateActionResult{Resource: a.Resource, ID: a.ID, Action: a.Action, Result: "done"}
+		if failed != nil {
+			result.Result = "skipped"
+		} else if err := plan.apply[i](out); err != nil {
+			failed = fmt.Errorf("%s %s %s: %w", a.Action, a.Resource, a.ID, err)
+			result.Result = "failed: " + err.Error()
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report, failed
+}
+
+func planCredential(conf config.Configuration, c model.StateCredential) ([]plannedAction, error) {
+	credential := model.UserCredential{Name: c.Name, Kind: c.Kind, Description: c.Description, User: c.User}
+	withPassword := func(out io.Writer, save func(io.Writer, config.Configuration, model.UserCredential) error) error {
+		if c.PasswordEnv != "" {
+			credential.Password = os.Getenv(c.PasswordEnv)
+			if credential.Password == "" {
+				return fmt.Errorf("%w: environment variable %s with the password of %s not set", ErrInvalid, c.PasswordEnv, c.Name)
+			}
+		}
+		return save(out, conf, credential)
+	}
+
+	current, err := InspectUserCredential(conf, c.Name)
+	if errors.Is(err, ErrNotFound) {
+		return []plannedAction{{
+			action: model.StateAction{Resource: "credential", ID: c.Name, Action: "create", Detail: passwordDetail(c)},
+			apply: func(out io.Writer) error {
+				return withPassword(out, CreateUserCredential)
+			},
+		}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var details []string
+	if c.User != current.D.User {
+		details = append(details, "user")
+	}
+	if c.Description != "" && c.Description != current.D.Description {
+		details = append(details, "description")
+	}
+	if c.Kind != "" && c.Kind != current.D.Kind {
+		details = append(details, "kind")
+	}
+	if len(details) == 0 {
+		return nil, nil
+	}
+	return []plannedAction{{
+		action: model.StateAction{Resource: "credential", ID: c.Name, Action: "update", Detail: strings.Join(details, ", ")},
+		apply: func(out io.Writer) error {
+			return withPassword(out, UpdateUserCredential)
+		},
+	}}, nil
+}
+
+func passwordDetail(c model.StateCredential) string {
+	if c.PasswordEnv == "" {
+		return "without password"
+	}
+	return "password from $" + c.PasswordEnv
+}
+
+func planPackage(conf config.Configuration, p model.StatePackage, existing []model.IntegrationPackage) []plannedAction {
+	desired := model.IntegrationPackage{ID: p.ID, Name: p.Name, ShortText: p.ShortText, Description: p.Description}
+	for _, e := range existing {
+		if e.ID != p.ID {
+			continue
+		}
+		var details []string
+		if p.Name != "" && p.Name != e.Name {
+			details = append(details, "name")
+			e.Name = p.Name
+		}
+		if p.ShortText != "" && p.ShortText != e.ShortText {
+			details = append(details, "short text")
+			e.ShortText = p.ShortText
+		}
+		if p.Description != "" && p.Description != e.Description {
+			details = append(details, "description")
+			e.Description = p.Description
+		}
+		if len(details) == 0 {
+			return nil
+		}
+		updated := model.IntegrationPackage{ID: e.ID, Name: e.Name, ShortText: e.ShortText, Description: e.Description}
+		return []plannedAction{{
+			action: model.StateAction{Resource: "package", ID: p.ID, Action: "update", Detail: strings.Join(details, ", ")},
+			apply: func(out io.Writer) error {
+				return UpdateIntegrationPackage(out, conf, updated)
+			},
+		}}
+	}
+
+	return []plannedAction{{
+		action: model.StateAction{Resource: "package", ID: p.ID, Action: "create"},
+		apply: func(out io.Writer) error {
+			return CreateIntegrationPackage(out, conf, desired)
+		},
+	}}
+}
+
+type flowPlan struct {
+	flow           []plannedAction
+	configurations []plannedAction
+	deployment     []plannedAction
+}
+
+func planFlow(conf config.Configuration, packageID string, f model.StateFlow, baseDir string) (*flowPlan, error) {
+	fp := &flowPlan{}
+
+	var source *iflow.Flow
+	if f.Source != "" {
+		sourcePath := f.Source
+		if !filepath.IsAbs(sourcePath) {
+			sourcePath = filepath.Join(baseDir, sourcePath)
+		}
+		var err error
+		if source, err = readFlowSource(sourcePath); err != nil {
+			return nil, fmt.Errorf("integration flow %s: %w", f.ID, err)
+		}
+	}
+
+	current, err := InspectArtifact(conf, FlowArtifact, f.ID, "active")
+	exists := true
+	if errors.Is(err, ErrNotFound) {
+		exists = false
+	} else if err != nil {
+		return nil, err
+	}
+
+	name := f.Name
+	if name == "" && source != nil {
+		name = source.Name()
+	}
+	if name == "" && exists {
+		name = current.D.Name
+	}
+	if name == "" {
+		name = f.ID
+	}
+	var content []byte
+	if source != nil {
+		if source.ID() != f.ID {
+			source.SetID(f.ID)
+		}
+		if source.Name() != name {
+			source.SetName(name)
+		}
+		if content, err = source.Bytes(); err != nil {
+			return nil, err
+		}
+	}
+
+	contentChanged := false
+	if !exists {
+		if source == nil {
+			return nil, fmt.Errorf("%w: integration flow %s does not exist, source is required to create it", ErrInvalid, f.ID)
+		}
+		contentChanged = true
+		fp.flow = append(fp.flow, plannedAction{
+			action: model.StateAction{Resource: "flow", ID: f.ID, Action: "create", Detail: "from " + f.Source},
+			apply: func(out io.Writer) error {
+				resp, err := CreateArtifact(conf, FlowArtifact, name, f.ID, packageID, bytes.NewReader(content))
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "%s: %s created\n", FlowArtifact.Description, resp.D.ID)
+				return nil
+			},
+		})
+	} else {
+		if current.D.PackageID != "" && current.D.PackageID != packageID {
+			log.Printf("Integration flow: %s exists in the package %s, it is not moved to the package %s", f.ID, current.D.PackageID, packageID)
+		}
+		var details []string
+		if name != current.D.Name {
+			details = append(details, "name")
+		}
+		if source != nil {
+			remote, err := downloadWorkspaceArtifact(conf, FlowArtifact, f.ID)
+			if err != nil {
+				return nil, err
+			}
+			if changed := changedFlowFiles(remote, source); len(changed) > 0 {
+				contentChanged = true
+				if len(changed) > 3 {
+					changed = append(changed[:3], "...")
+				}
+				details = append(details, "files "+strings.Join(changed, ", "))
+			}
+		}
+		if len(details) > 0 {
+			var updateContent []byte
+			if contentChanged {
+				updateContent = content
+			}
+			fp.flow = append(fp.flow, plannedAction{
+				action: model.StateAction{Resource: "flow", ID: f.ID, Action: "update", Detail: strings.Join(details, "; ")},
+				apply: func(out io.Writer) error {
+					return UpdateArtifact(out, conf, FlowArtifact, name, f.ID, "active", bytes.NewReader(updateContent))
+				},
+			})
+		}
+	}
+
+	configsChanged, err := planFlowConfigurations(conf, fp, f, source, exists, contentChanged)
+	if err != nil {
+		return nil, err
+	}
+
+	if !f.Deploy {
+		return fp, nil
+	}
+	reason := ""
+	switch {
+	case contentChanged || len(fp.flow) > 0:
+		reason = "flow changed"
+	case configsChanged:
+		reason = "configurations changed"
+	default:
+		runtime, err := GetRuntimeArtifact(conf, f.ID)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			reason = "not deployed"
+		case err != nil:
+			return nil, err
+		case runtime.D.Version != current.D.Version:
+			reason = "deployed version " + runtime.D.Version + ", designtime version " + current.D.Version
+		case runtime.D.Status == "ERROR":
+			reason = "deployment status ERROR"
+		}
+	}
+	if reason != "" {
+		fp.deployment = append(fp.deployment, plannedAction{
+			action: model.StateAction{Resource: "flow", ID: f.ID, Action: "deploy", Detail: reason},
+			apply: func(out io.Writer) error {
+				return DeployArtifact(out, conf, FlowArtifact, f.ID, "active")
+			},
+		})
+	}
+	return fp, nil
+}
+
+//planFlowConfigurations plans the update of the configuration parameters with different values.
+//The parameters not yet externalized in the tenant are accepted only when the flow is created or its content is updated.
+func planFlowConfigurations(conf config.Configuration, fp *flowPlan, f model.StateFlow, source *iflow.Flow, exists bool, contentChanged bool) (bool, error) {
+	if len(f.Configurations) == 0 {
+		return false, nil
+	}
+
+	current := map[string]model.FlowConfiguration{}
+	if exists {
+		resp, err := GetFlowConfigs(conf, f.ID, "active")
+		if err != nil {
+			return false, err
+		}
+		for _, c := range resp.D.Results {
+			current[c.ParameterKey] = c
+		}
+	}
+
+	var keys []string
+	for k := range f.Configurations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var configs []model.FlowConfigurationPrinter
+	var changed []string
+	for _, k := range keys {
+		c, ok := current[k]
+		if !ok && !contentChanged {
+			return false, fmt.Errorf("%w: parameter %s is not externalized in the integration flow %s", ErrInvalid, k, f.ID)
+		}
+		if ok && c.ParameterValue == f.Configurations[k] {
+			continue
+		}
+		dataType := c.DataType
+		if dataType == "" {
+			dataType = sourceParameterType(source, k)
+		}
+		configs = append(configs, model.FlowConfigurationPrinter{ParameterKey: k, ParameterValue: f.Configurations[k], DataType: dataType})
+		changed = append(changed, k)
+	}
+	if len(configs) == 0 {
+		return false, nil
+	}
+
+	fp.configurations = append(fp.configurations, plannedAction{
+		action: model.StateAction{Resource: "configuration", ID: f.ID, Action: "update", Detail: strings.Join(changed, ", ")},
+		apply: func(out io.Writer) error {
+			if _, err := UpdateFlowConfigs(conf, f.ID, configs); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Configurations of the integration flow: %s updated: %s\n", f.ID, strings.Join(changed, ", "))
+			return nil
+		},
+	})
+	return true, nil
+}
+
+func sourceParameterType(source *iflow.Flow, key string) string {
+	if source != nil {
+		for _, d := range source.ParameterDefinitions {
+			if d.Key == key && d.Type != "" {
+				return d.Type
+			}
+		}
+	}
+	return "xsd:string"
+}
+
+//changedFlowFiles compares the flows, the manifest is compared by the headers and .project by the name,
+//so the differences of the line folding are ignored
+func changedFlowFiles(remote *iflow.Flow, source *iflow.Flow) []string {
+	remoteFiles := flowFiles(remote)
+	sourceFiles := flowFiles(source)
+	for _, name := range []string{iflow.ManifestPath, iflow.ProjectPath} {
+		delete(remoteFiles, name)
+		delete(sourceFiles, name)
+	}
+
+	changed := changedFiles(remoteFiles, sourceFiles)
+	if !reflect.DeepEqual(remote.Manifest.Headers, source.Manifest.Headers) {
+		changed = append([]string{iflow.ManifestPath}, changed...)
+	}
+	if remote.Project != nil && source.Project != nil && remote.Project.Name != source.Project.Name {
+		changed = append([]string{iflow.ProjectPath}, changed...)
+	}
+	return changed
+}
@@ -0,0 +1,230 @@
+package client_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+const testStateDocument = `tenants:
+  test:
+    credentials:
+      - name: SFTP_User
+        user: sftpuser
+        passwordEnv: CIG_TEST_SFTP_PASSWORD
+    packages:
+      - id: POscenerio
+        name: Purchase Orders
+        flows:
+          - id: PurchaseOrder
+            source: flows/PurchaseOrder
+            configurations:
+              SFTP_Host: sftp.partner.com
+            deploy: true
+      - id: Invoices
+        name: Invoices
+        flows:
+          - id: Invoice
+            name: Invoice Replication
+            source: flows/Invoice.zip
+            configurations:
+              Timeout: "60"
+  prod:
+    packages:
+      - id: POscenerio
+`
+
+func TestPlanApplyState(t *testing.T) {
+	dir := t.TempDir()
+	stateFile := filepath.Join(dir, "cig.yaml")
+	os.WriteFile(stateFile, []byte(testStateDocument), 0666)
+
+	sourceFiles := map[string]string{
+		"META-INF/MANIFEST.MF": "Manifest-Version: 1.0\r\nBundle-SymbolicName: PurchaseOrder\r\nBundle-Name: PurchaseOrder\r\n",
+		".project":             "<projectDescription><name>PurchaseOrder</name></projectDescription>",
+		"src/main/resources/script/script1.groovy": "new script\n",
+	}
+	for name, content := range sourceFiles {
+		filePath := filepath.Join(dir, "flows", "PurchaseOrder", filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+		os.WriteFile(filePath, []byte(content), 0666)
+	}
+	os.WriteFile(filepath.Join(dir, "flows", "Invoice.zip"), newTestArtifactZip(t, "Invoice", nil), 0666)
+
+	remoteContent := newTestArtifactZip(t, "PurchaseOrder", map[string]string{
+		"src/main/resources/script/script1.groovy": "old script\n",
+	})
+
+	failCredential := false
+	var requests []string
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "GET" {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				if failCredential && r.URL.Path == "/UserCredentials" {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"d": {"Id": "Invoice"}}`)
+				return
+			}
+			switch r.URL.Path {
+			case "/":
+				w.WriteHeader(http.StatusOK)
+			case "/IntegrationPackages":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, `{"d": {"results": [{"Id": "POscenerio", "Name": "Purchase Orders"}]}}`)
+			case "/IntegrationDesigntimeArtifacts(Id='PurchaseOrder',Version='active')":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, `{"d": {"Id": "PurchaseOrder", "Version": "1.0.0", "PackageId": "POscenerio", "Name": "PurchaseOrder"}}`)
+			case "/IntegrationDesigntimeArtifacts(Id='PurchaseOrder',Version='active')/$value":
+				w.WriteHeader(http.StatusOK)
+				w.Write(remoteContent)
+			case "/IntegrationDesigntimeArtifacts(Id='PurchaseOrder',Version='active')/Configurations":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, `{"d": {"results": [{"ParameterKey": "SFTP_Host", "ParameterValue": "sftp.example.com", "DataType": "xsd:string"}]}}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	doc, err := client.ReadStateDocument(stateFile)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	plan, err := client.PlanState(conf, doc, dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	expActions := []model.StateAction{
+		{Resource: "credential", ID: "SFTP_User", Action: "create", Detail: "password from $CIG_TEST_SFTP_PASSWORD"},
+		{Resource: "package", ID: "Invoices", Action: "create"},
+		{Resource: "flow", ID: "PurchaseOrder", Action: "update", Detail: "files src/main/resources/script/script1.groovy"},
+		{Resource: "flow", ID: "Invoice", Action: "create", Detail: "from flows/Invoice.zip"},
+		{Resource: "configuration", ID: "PurchaseOrder", Action: "update", Detail: "SFTP_Host"},
+		{Resource: "configuration", ID: "Invoice", Action: "update", Detail: "Timeout"},
+		{Resource: "flow", ID: "PurchaseOrder", Action: "deploy", Detail: "flow changed"},
+	}
+	if !reflect.DeepEqual(plan.Actions, expActions) {
+		t.Fatalf("Expected actions:\n%+v\ngot:\n%+v", expActions, plan.Actions)
+	}
+
+	var out bytes.Buffer
+	_, err = client.ApplyState(&out, plan)
+	if !errors.Is(err, client.ErrInvalid) {
+		t.Errorf("Expected error %q for missing password, got %q.", client.ErrInvalid, err)
+	}
+
+	t.Setenv("CIG_TEST_SFTP_PASSWORD", "secret")
+	requests = nil
+	report, err := client.ApplyState(&out, plan)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	expRequests := []string{
+		"POST /UserCredentials",
+		"POST /IntegrationPackages",
+		"PUT /IntegrationDesigntimeArtifacts(Id='PurchaseOrder',Version='active')",
+		"POST /IntegrationDesigntimeArtifacts",
+		"PUT /IntegrationDesigntimeArtifacts(Id='PurchaseOrder',Version='active')/$links/Configurations('SFTP_Host')",
+		"PUT /IntegrationDesigntimeArtifacts(Id='Invoice',Version='active')/$links/Configurations('Timeout')",
+		"POST /DeployIntegrationDesigntimeArtifact",
+	}
+	if !reflect.DeepEqual(requests, expRequests) {
+		t.Errorf("Expected requests:\n%v\ngot:\n%v", expRequests, requests)
+	}
+	for _, r := range report.Results {
+		if r.Result != "done" {
+			t.Errorf("Unexpected result %+v", r)
+		}
+	}
+
+	failCredential = true
+	report, err = client.ApplyState(&out, plan)
+	if err == nil {
+		t.Fatalf("Expected error, got no error.")
+	}
+	if report.Results[0].Result == "done" || report.Results[1].Result != "skipped" || report.Results[6].Result != "skipped" {
+		t.Errorf("Unexpected report %+v", report.Results)
+	}
+
+	conf.Key = "unknown"
+	if _, err := client.PlanState(conf, doc, dir); !errors.Is(err, client.ErrInvalid) {
+		t.Errorf("Expected error %q, got %q.", client.ErrInvalid, err)
+	}
+}
+
+func TestPlanStateExistingFlowName(t *testing.T) {
+	doc := &model.StateDocument{Tenants: map[string]model.TenantState{
+		"test": {Packages: []model.StatePackage{
+			{ID: "POscenerio", Flows: []model.StateFlow{{ID: "PurchaseOrder", Deploy: true}}},
+		}},
+	}}
+
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/":
+				w.WriteHeader(http.StatusOK)
+			case "/IntegrationPackages":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, `{"d": {"results": [{"Id": "POscenerio", "Name": "Purchase Orders"}]}}`)
+			case "/IntegrationDesigntimeArtifacts(Id='PurchaseOrder',Version='active')":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, `{"d": {"Id": "PurchaseOrder", "Version": "1.0.0", "PackageId": "POscenerio", "Name": "Purchase Order Replication"}}`)
+			case "/IntegrationRuntimeArtifacts('PurchaseOrder')":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, `{"d": {"Id": "PurchaseOrder", "Version": "1.0.0", "Status": "STARTED"}}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	plan, err := client.PlanState(conf, doc, t.TempDir())
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if len(plan.Actions) != 0 {
+		t.Errorf("Expected no actions, got:\n%+v", plan.Actions)
+	}
+}
+
+func TestReadStateDocumentRequiredFields(t *testing.T) {
+	testCases := []struct {
+		name string
+		doc  string
+	}{
+		{name: "credentialName", doc: "tenants:\n  test:\n    credentials:\n      - user: sftpuser\n"},
+		{name: "credentialUser", doc: "tenants:\n  test:\n    credentials:\n      - name: SFTP_User\n        passwordEnv: SFTP_PASSWORD\n"},
+		{name: "packageID", doc: "tenants:\n  test:\n    packages:\n      - name: Purchase Orders\n"},
+		{name: "flowID", doc: "tenants:\n  test:\n    packages:\n      - id: POscenerio\n        flows:\n          - source: flows/PurchaseOrder\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stateFile := filepath.Join(t.TempDir(), "cig.yaml")
+			os.WriteFile(stateFile, []byte(tc.doc), 0666)
+			if _, err := client.ReadStateDocument(stateFile); !errors.Is(err, client.ErrInvalid) {
+				t.Errorf("Expected error %q, got %q.", client.ErrInvalid, err)
+			}
+		})
+	}
+}
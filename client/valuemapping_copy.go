@@ -0,0 +1,21 @@
+package client
+
+import (
+	"io"
+	"log"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunCopyValueMapping - call the function CopyValueMapping
+func RunCopyValueMapping(out io.Writer, conf config.Configuration, srcID string, destID string, destName string, destPackageID string) {
+	err := CopyValueMapping(out, conf, srcID, destID, destName, destPackageID)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+//CopyValueMapping is the function to copy value mappings in the same system
+func CopyValueMapping(out io.Writer, conf config.Configuration, srcID string, destID string, destName string, destPackageID string) error {
+	return CopyArtifact(out, conf, ValueMappingArtifact, srcID, destID, destName, destPackageID)
+}
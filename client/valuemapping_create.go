@@ -0,0 +1,34 @@
+package client
+
+import (
+	"io"
+	"log"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//RunCreateValueMapping - call the function CreateValueMapping
+func RunCreateValueMapping(out io.Writer, conf config.Configuration, name string, id string, packageid string, fileName string) {
+	var fileContent io.ReadCloser
+	var err error
+	if fileName != "" {
+		fileContent, err = os.Open(fileName)
+		if err != nil {
+			log.Fatal("Error Openning file:\n", err)
+		}
+		defer fileContent.Close()
+	}
+
+	resp, err := CreateValueMapping(conf, name, id, packageid, fileContent)
+	if err != nil {
+		log.Fatal("Error in CreateValueMapping:\n", err)
+	}
+	resp.Print(out)
+}
+
+//CreateValueMapping - create value mapping, it is possible to create empty value mapping or with content
+func CreateValueMapping(conf config.Configuration, name string, id string, packageid string, valueMappingContent io.Reader) (*model.ArtifactByIdResponse, error) {
+	return CreateArtifact(conf, ValueMappingArtifact, name, id, packageid, valueMappingContent)
+}
@@ -0,0 +1,409 @@
+package client
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/rand"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+const valueMappingFileName = "value_mapping.xml"
+
+//RunExportValueMapping - call the function ExportValueMapping
+func RunExportValueMapping(out io.Writer, conf config.Configuration, id string, version string, csvFileName string) {
+	csvOutput := out
+	if csvFileName != "" {
+		csvFile, err := os.OpenFile(csvFileName, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+		if err != nil {
+			log.Fatal("Error creating file:\n", err)
+		}
+		defer csvFile.Close()
+		csvOutput = csvFile
+	}
+
+	err := ExportValueMapping(conf, id, version, csvOutput)
+	if err != nil {
+		log.Fatal("Error in ExportValueMapping:\n", err)
+	}
+}
+
+//ExportValueMapping - download value mapping and write its entries as CSV
+func ExportValueMapping(conf config.Configuration, id string, version string, csvOutput io.Writer) error {
+	var content bytes.Buffer
+	err := DownloadValueMapping(io.Discard, conf, id, version, &content)
+	if err != nil {
+		return err
+	}
+
+	doc, err := readValueMappingDocument(content.Bytes())
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(csvOutput)
+	if err := w.Write(model.ValueMappingCSVHeader); err != nil {
+		return err
+	}
+	for _, row := range valueMappingRows(doc) {
+		if err := w.Write(row.Record()); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+
+	return w.Error()
+}
+
+//RunImportValueMapping - call the function ImportValueMapping
+func RunImportValueMapping(out io.Writer, conf config.Configuration, id string, version string, csvFileName string) {
+	csvFile, err := os.Open(csvFileName)
+	if err != nil {
+		log.Fatal("Error Openning file:\n", err)
+	}
+	defer csvFile.Close()
+
+	err = ImportValueMapping(out, conf, id, version, csvFile)
+	if err != nil {
+		log.Fatal("Error in ImportValueMapping:\n", err)
+	}
+}
+
+//ImportValueMapping - replace the entries of the value mapping with entries from CSV and upload it
+func ImportValueMapping(out io.Writer, conf config.Configuration, id string, version string, csvInput io.Reader) error {
+	newRows, err := readValueMappingCSV(csvInput)
+	if err != nil {
+		return err
+	}
+
+	valueMapping, err := InspectValueMapping(conf, id, version)
+	if err != nil {
+		return err
+	}
+
+	var content bytes.Buffer
+	err = DownloadValueMapping(io.Discard, conf, id, version, &content)
+	if err != nil {
+		return err
+	}
+
+	oldDoc, err := readValueMappingDocument(content.Bytes())
+	if err != nil {
+		return err
+	}
+	oldRows := valueMappingRows(oldDoc)
+
+	added, removed, changed := diffValueMappingRows(oldRows, newRows)
+	if len(added)+len(removed)+len(changed) == 0 {
+		fmt.Fprintf(out, "Value mapping: %s is up to date\n", id)
+		return nil
+	}
+
+	newDoc, err := valueMappingFromRows(oldDoc.Version, oldRows, newRows)
+	if err != nil {
+		return err
+	}
+
+	newXML, err := xml.MarshalIndent(newDoc, "", "\t")
+	if err != nil {
+		return err
+	}
+	newXML = append([]byte(xml.Header), newXML...)
+
+	newContent, err := replaceZipEntry(content.Bytes(), valueMappingFileName, newXML)
+	if err != nil {
+		return err
+	}
+
+	err = UpdateValueMapping(out, conf, valueMapping.D.Name, id, version, bytes.NewReader(newContent))
+	if err != nil {
+		return err
+	}
+
+	for _, r := range added {
+		fmt.Fprintf(out, "+ %s/%s %q -> %s/%s %q\n", r.SourceAgency, r.SourceSchema, r.SourceValue, r.TargetAgency, r.TargetSchema, r.TargetValue)
+	}
+	for _, r := range removed {
+		fmt.Fprintf(out, "- %s/%s %q -> %s/%s %q\n", r.SourceAgency, r.SourceSchema, r.SourceValue, r.TargetAgency, r.TargetSchema, r.TargetValue)
+	}
+	for _, r := range changed {
+		fmt.Fprintf(out, "~ %s/%s %q -> %s/%s %q\n", r.SourceAgency, r.SourceSchema, r.SourceValue, r.TargetAgency, r.TargetSchema, r.TargetValue)
+	}
+	fmt.Fprintf(out, "added: %d, removed: %d, changed: %d\n", len(added), len(removed), len(changed))
+
+	return nil
+}
+
+func readValueMappingDocument(zipContent []byte) (*model.ValueMappingDocument, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(zipContent), int64(len(zipContent)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot read value mapping archive: %s", ErrInvalid, err)
+	}
+
+	for _, f := range zipReader.File {
+		if path.Base(f.Name) != valueMappingFileName {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+
+		var doc model.ValueMappingDocument
+		if err := xml.NewDecoder(rc).Decode(&doc); err != nil {
+			return nil, fmt.Errorf("%w: cannot parse %s: %s", ErrInvalid, f.Name, err)
+		}
+		return &doc, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s not found in value mapping archive", ErrInvalid, valueMappingFileName)
+}
+
+//valueMappingRows flattens the groups, the first entry of the group is the source of each row
+func valueMappingRows(doc *model.ValueMappingDocument) []model.ValueMappingRow {
+	var rows []model.ValueMappingRow
+	for _, g := range doc.Groups {
+		if len(g.Entries) == 0 {
+			continue
+		}
+		src := g.Entries[0]
+		if len(g.Entries) == 1 {
+			rows = append(rows, model.ValueMappingRow{
+				GroupID:      g.ID,
+				SourceAgency: src.Agency,
+				SourceSchema: src.Schema,
+				SourceValue:  src.Value,
+			})
+			continue
+		}
+		for _, target := range g.Entries[1:] {
+			rows = append(rows, model.ValueMappingRow{
+				GroupID:      g.ID,
+				SourceAgency: src.Agency,
+				SourceSchema: src.Schema,
+				SourceValue:  src.Value,
+				TargetAgency: target.Agency,
+				TargetSchema: target.Schema,
+				TargetValue:  target.Value,
+			})
+		}
+	}
+	return rows
+}
+
+//valueMappingFromRows builds the document from CSV rows, group ids of existing mappings are kept
+func valueMappingFromRows(version string, oldRows []model.ValueMappingRow, newRows []model.ValueMappingRow) (*model.ValueMappingDocument, error) {
+	if version == "" {
+		version = "2.0"
+	}
+	doc := &model.ValueMappingDocument{Version: version}
+
+	existingGroupIDs := map[string]string{}
+	for _, r := range oldRows {
+		existingGroupIDs[r.Key()] = r.GroupID
+	}
+
+	groupIndex := map[string]int{}
+	keys := map[string]bool{}
+	for _, r := range newRows {
+		if keys[r.Key()] {
+			return nil, fmt.Errorf("%w: duplicate row %s/%s %q -> %s/%s", ErrInvalid,
+				r.SourceAgency, r.SourceSchema, r.SourceValue, r.TargetAgency, r.TargetSchema)
+		}
+		keys[r.Key()] = true
+
+		groupID := r.GroupID
+		if groupID == "" {
+			groupID = existingGroupIDs[r.Key()]
+		}
+		if groupID == "" {
+			var err error
+			groupID, err = newValueMappingGroupID()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		i, ok := groupIndex[groupID]
+		if !ok {
+			doc.Groups = append(doc.Groups, model.ValueMappingGroup{
+				ID: groupID,
+				Entries: []model.ValueMappingEntry{
+					{Agency: r.SourceAgency, Schema: r.SourceSchema, Value: r.SourceValue},
+				},
+			})
+			i = len(doc.Groups) - 1
+			groupIndex[groupID] = i
+		}
+		src := doc.Groups[i].Entries[0]
+		if src.Agency != r.SourceAgency || src.Schema != r.SourceSchema || src.Value != r.SourceValue {
+			return nil, fmt.Errorf("%w: group %s has different sources %s/%s %q and %s/%s %q", ErrInvalid, groupID,
+				src.Agency, src.Schema, src.Value, r.SourceAgency, r.SourceSchema, r.SourceValue)
+		}
+		if r.TargetAgency != "" || r.TargetSchema != "" {
+			doc.Groups[i].Entries = append(doc.Groups[i].Entries,
+				model.ValueMappingEntry{Agency: r.TargetAgency, Schema: r.TargetSchema, Value: r.TargetValue})
+		}
+	}
+
+	return doc, nil
+}
+
+func readValueMappingCSV(csvInput io.Reader) ([]model.ValueMappingRow, error) {
+	r := csv.NewReader(csvInput)
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot read CSV header: %s", ErrInvalid, err)
+	}
+	columns := map[string]int{}
+	for i, h := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))] = i
+	}
+	for _, h := range model.ValueMappingCSVHeader[1:] {
+		if _, ok := columns[h]; !ok {
+			return nil, fmt.Errorf("%w: CSV column %s is missing", ErrInvalid, h)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []model.ValueMappingRow
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+		}
+		row := model.ValueMappingRow{
+			GroupID:      field(record, "GroupId"),
+			SourceAgency: field(record, "SourceAgency"),
+			SourceSchema: field(record, "SourceSchema"),
+			SourceValue:  field(record, "SourceValue"),
+			TargetAgency: field(record, "TargetAgency"),
+			TargetSchema: field(record, "TargetSchema"),
+			TargetValue:  field(record, "TargetValue"),
+		}
+		if row == (model.ValueMappingRow{}) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func diffValueMappingRows(oldRows []model.ValueMappingRow, newRows []model.ValueMappingRow) (added, removed, changed []model.ValueMappingRow) {
+	oldByKey := map[string]model.ValueMappingRow{}
+	for _, r := range oldRows {
+		oldByKey[r.Key()] = r
+	}
+	newByKey := map[string]model.ValueMappingRow{}
+	for _, r := range newRows {
+		newByKey[r.Key()] = r
+	}
+
+	for key, r := range newByKey {
+		old, ok := oldByKey[key]
+		if !ok {
+			added = append(added, r)
+		} else if old.TargetValue != r.TargetValue {
+			changed = append(changed, r)
+		}
+	}
+	for key, r := range oldByKey {
+		if _, ok := newByKey[key]; !ok {
+			removed = append(removed, r)
+		}
+	}
+
+	for _, rows := range [][]model.ValueMappingRow{added, removed, changed} {
+		sort.Slice(rows, func(i, j int) bool { return rows[i].Key() < rows[j].Key() })
+	}
+
+	return added, removed, changed
+}
+
+func newValueMappingGroupID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+//replaceZipEntry returns copy of the archive with content of the file name replaced
+func replaceZipEntry(zipContent []byte, name string, content []byte) ([]byte, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(zipContent), int64(len(zipContent)))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	replaced := false
+	for _, f := range zipReader.File {
+		if path.Base(f.Name) == name {
+			w, err := writer.CreateHeader(&zip.FileHeader{Name: f.Name, Method: zip.Deflate, Modified: f.Modified})
+			if err != nil {
+				return nil, err
+			}
+			if _, err := w.Write(content); err != nil {
+				return nil, err
+			}
+			replaced = true
+			continue
+		}
+		w, err := writer.CreateHeader(&zip.FileHeader{Name: f.Name, Method: f.Method, Modified: f.Modified})
+		if err != nil {
+			return nil, err
+		}
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.Copy(w, rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !replaced {
+		w, err := writer.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
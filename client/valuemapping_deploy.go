@@ -0,0 +1,21 @@
+package client
+
+import (
+	"io"
+	"log"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunDeployValueMapping - call the function DeployValueMapping
+func RunDeployValueMapping(out io.Writer, conf config.Configuration, id string, version string) {
+	err := DeployValueMapping(out, conf, id, version)
+	if err != nil {
+		log.Fatal("Error in DeployValueMapping:\n", err)
+	}
+}
+
+//DeployValueMapping - deploy value mapping
+func DeployValueMapping(out io.Writer, conf config.Configuration, id string, version string) error {
+	return DeployArtifact(out, conf, ValueMappingArtifact, id, version)
+}
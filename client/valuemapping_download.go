@@ -0,0 +1,32 @@
+package client
+
+import (
+	"io"
+	"log"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunDownloadValueMapping - call the function DownloadValueMapping
+func RunDownloadValueMapping(out io.Writer, conf config.Configuration, valueMappingID string, version string, outputFile string) {
+	if outputFile == "" {
+		outputFile = valueMappingID + ".zip"
+	}
+
+	outputContent, err := os.OpenFile(outputFile, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		log.Fatal("Error Openning file:\n", err)
+	}
+	defer outputContent.Close()
+
+	err = DownloadValueMapping(out, conf, valueMappingID, version, outputContent)
+	if err != nil {
+		log.Fatal("Error in DownloadValueMapping: ", err)
+	}
+}
+
+//DownloadValueMapping is the function to download value mapping content
+func DownloadValueMapping(out io.Writer, conf config.Configuration, valueMappingID string, version string, outputContent io.Writer) error {
+	return DownloadArtifact(out, conf, ValueMappingArtifact, valueMappingID, version, outputContent)
+}
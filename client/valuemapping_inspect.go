@@ -0,0 +1,23 @@
+package client
+
+import (
+	"io"
+	"log"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//RunInspectValueMapping - call the function InspectValueMapping
+func RunInspectValueMapping(out io.Writer, conf config.Configuration, valueMappingID string, version string) {
+	resp, err := InspectValueMapping(conf, valueMappingID, version)
+	if err != nil {
+		log.Fatal("Error in InspectValueMapping:\n", err)
+	}
+	resp.Print(out)
+}
+
+//InspectValueMapping - inspect value mapping
+func InspectValueMapping(conf config.Configuration, valueMappingID string, version string) (*model.ArtifactByIdResponse, error) {
+	return InspectArtifact(conf, ValueMappingArtifact, valueMappingID, version)
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"io"
+	"log"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+//RunGetValueMappingsOfIntegrationPackage - call the function GetValueMappingsOfIntegrationPackage
+func RunGetValueMappingsOfIntegrationPackage(out io.Writer, conf config.Configuration, packageID string) {
+	resp, err := GetValueMappingsOfIntegrationPackage(conf, packageID)
+	if err != nil {
+		log.Fatal("Error in GetValueMappingsOfIntegrationPackage:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetValueMappingsOfIntegrationPackage is the function to get list of value mappings of the integration package
+func GetValueMappingsOfIntegrationPackage(conf config.Configuration, packageID string) (*model.ArtifactsOfIPResponse, error) {
+	return GetArtifactsOfIntegrationPackage(conf, ValueMappingArtifact, packageID)
+}
@@ -0,0 +1,363 @@
+package client_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+)
+
+func TestGetValueMappingsOfIntegrationPackage(t *testing.T) {
+	testResp := map[string]struct {
+		Status int
+		Body   string
+	}{
+		"ok": {
+			Status: http.StatusOK,
+			Body: `{
+	"d": {
+		"results": [
+			{
+				"Id": "VM_Countries",
+				"Version": "1.0.0",
+				"PackageId": "POscenerio",
+				"Name": "VM Countries",
+				"Description": ""
+			},
+			{
+				"Id": "VM_Currencies",
+				"Version": "1.0.2",
+				"PackageId": "POscenerio",
+				"Name": "VM Currencies",
+				"Description": ""
+			}
+		]
+	}
+}`,
+		},
+		"notFound": {
+			Status: http.StatusNotFound,
+			Body:   `{"error":{"code":"Not Found","message":{"lang":"en","value":"Integration package not found"}}}`,
+		},
+	}
+
+	conf := getTestConfiguration()
+
+	testCases := []struct {
+		name      string
+		packageId string
+		expError  error
+		expCount  int
+		resp      struct {
+			Status int
+			Body   string
+		}
+		closeServer bool
+	}{
+		{
+			name:      "ok",
+			packageId: "POscenerio",
+			expCount:  2,
+			resp:      testResp["ok"],
+		},
+		{
+			name:      "notFound",
+			packageId: "notExistingPackageId",
+			expError:  client.ErrNotFound,
+			resp:      testResp["notFound"],
+		},
+		{
+			name:        "InvalidURL",
+			packageId:   "POscenerio",
+			expError:    client.ErrConnection,
+			resp:        testResp["notFound"],
+			closeServer: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(tc.resp.Status)
+					fmt.Fprintln(w, tc.resp.Body)
+				})
+			defer cleanup()
+			if tc.closeServer {
+				cleanup()
+			}
+
+			conf.ApiURL = url
+			resp, err := client.GetValueMappingsOfIntegrationPackage(conf, tc.packageId)
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if len(resp.D.Results) != tc.expCount {
+				t.Errorf("Expected %d value mappings, got %d", tc.expCount, len(resp.D.Results))
+			}
+		})
+	}
+}
+
+func TestTransportValueMapping(t *testing.T) {
+	inspectBody := `{"d": {"Id": "VM_Countries", "Version": "1.0.0", "PackageId": "POscenerio", "Name": "VM Countries"}}`
+	createBody := `{"d": {"Id": "VM_CountriesCopy", "Version": "1.0.0", "PackageId": "POscenerio", "Name": "VM Countries"}}`
+	notFoundBody := `{"error":{"code":"Not Found","message":{"lang":"en","value":"Value mapping not found."}}}`
+
+	testCases := []struct {
+		name       string
+		srcId      string
+		destId     string
+		destExists bool
+		expError   error
+		expOut     string
+	}{
+		{
+			name:     "create",
+			srcId:    "VM_Countries",
+			destId:   "VM_CountriesCopy",
+			expOut:   "Value mapping created.",
+			expError: nil,
+		},
+		{
+			name:       "update",
+			srcId:      "VM_Countries",
+			destId:     "VM_Countries",
+			destExists: true,
+			expOut:     "Value mapping: VM_Countries updated",
+		},
+		{
+			name:     "notFound",
+			srcId:    "notExistingId",
+			destId:   "VM_CountriesCopy",
+			expError: client.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content := newTestArtifactZip(t, tc.srcId, nil)
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					urlPath := r.URL.Path
+					switch {
+					case r.Method == "POST":
+						w.WriteHeader(http.StatusCreated)
+						fmt.Fprintln(w, createBody)
+					case r.Method == "PUT":
+						w.WriteHeader(http.StatusOK)
+					case strings.Contains(urlPath, "Id='notExistingId'"),
+						!tc.destExists && strings.Contains(urlPath, "Id='"+tc.destId+"'"):
+						w.WriteHeader(http.StatusNotFound)
+						fmt.Fprintln(w, notFoundBody)
+					case strings.HasSuffix(urlPath, "$value"):
+						w.WriteHeader(http.StatusOK)
+						w.Write(content)
+					default:
+						w.WriteHeader(http.StatusOK)
+						fmt.Fprintln(w, inspectBody)
+					}
+				})
+			defer cleanup()
+
+			conf := getTestConfiguration()
+			conf.ApiURL = url
+			destConf := getTestConfiguration()
+			destConf.ApiURL = url
+
+			var out bytes.Buffer
+			err := client.TransportValueMapping(&out, conf, tc.srcId, destConf, tc.destId, "", "")
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if !strings.Contains(out.String(), tc.expOut) {
+				t.Errorf("Expected output to contain %q, got %q", tc.expOut, out.String())
+			}
+		})
+	}
+}
+
+const testValueMappingXML = `<?xml version="1.0" encoding="UTF-8"?>
+<vm version="2.0">
+	<group id="0001">
+		<entry><agency>SAP</agency><schema>Country</schema><value>DE</value></entry>
+		<entry><agency>Legacy</agency><schema>Country</schema><value>GER</value></entry>
+	</group>
+	<group id="0002">
+		<entry><agency>SAP</agency><schema>Country</schema><value>PL</value></entry>
+		<entry><agency>Legacy</agency><schema>Country</schema><value>POL</value></entry>
+	</group>
+</vm>`
+
+func TestExportValueMapping(t *testing.T) {
+	content := newTestArtifactZip(t, "VM_Countries", map[string]string{"value_mapping.xml": testValueMappingXML})
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write(content)
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	var csvOut bytes.Buffer
+	err := client.ExportValueMapping(conf, "VM_Countries", "active", &csvOut)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	exp := "GroupId,SourceAgency,SourceSchema,SourceValue,TargetAgency,TargetSchema,TargetValue\n" +
+		"0001,SAP,Country,DE,Legacy,Country,GER\n" +
+		"0002,SAP,Country,PL,Legacy,Country,POL\n"
+	if csvOut.String() != exp {
+		t.Errorf("Expected CSV:\n%s\ngot:\n%s", exp, csvOut.String())
+	}
+}
+
+func TestImportValueMapping(t *testing.T) {
+	content := newTestArtifactZip(t, "VM_Countries", map[string]string{"value_mapping.xml": testValueMappingXML})
+	inspectBody := `{"d": {"Id": "VM_Countries", "Version": "1.0.0", "PackageId": "POscenerio", "Name": "VM Countries"}}`
+
+	testCases := []struct {
+		name      string
+		csv       string
+		expError  error
+		expOut    []string
+		expValues []string
+	}{
+		{
+			name: "changes",
+			csv: "GroupId,SourceAgency,SourceSchema,SourceValue,TargetAgency,TargetSchema,TargetValue\n" +
+				"0001,SAP,Country,DE,Legacy,Country,DEU\n" +
+				",SAP,Country,FR,Legacy,Country,FRA\n",
+			expOut:    []string{"added: 1, removed: 1, changed: 1", "+ SAP/Country \"FR\"", "- SAP/Country \"PL\"", "~ SAP/Country \"DE\""},
+			expValues: []string{"DEU", "FRA"},
+		},
+		{
+			name: "upToDate",
+			csv: "SourceAgency,SourceSchema,SourceValue,TargetAgency,TargetSchema,TargetValue\n" +
+				"SAP,Country,DE,Legacy,Country,GER\n" +
+				"SAP,Country,PL,Legacy,Country,POL\n",
+			expOut: []string{"is up to date"},
+		},
+		{
+			name: "duplicateRow",
+			csv: "SourceAgency,SourceSchema,SourceValue,TargetAgency,TargetSchema,TargetValue\n" +
+				"SAP,Country,DE,Legacy,Country,GER\n" +
+				"SAP,Country,DE,Legacy,Country,DEU\n",
+			expError: client.ErrInvalid,
+		},
+		{
+			name: "groupWithDifferentSources",
+			csv: "GroupId,SourceAgency,SourceSchema,SourceValue,TargetAgency,TargetSchema,TargetValue\n" +
+				"0001,SAP,Country,DE,Legacy,Country,GER\n" +
+				"0001,SAP,Country,AT,Legacy,Country,AUT\n",
+			expError: client.ErrInvalid,
+		},
+		{
+			name:     "missingColumn",
+			csv:      "SourceAgency,SourceSchema,SourceValue\nSAP,Country,DE\n",
+			expError: client.ErrInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var uploaded []byte
+			url, cleanup := mockServer(
+				func(w http.ResponseWriter, r *http.Request) {
+					switch {
+					case r.Method == "PUT":
+						var body map[string]string
+						json.NewDecoder(r.Body).Decode(&body)
+						uploaded, _ = base64.StdEncoding.DecodeString(body["ArtifactContent"])
+						w.WriteHeader(http.StatusOK)
+					case strings.HasSuffix(r.URL.Path, "$value"):
+						w.WriteHeader(http.StatusOK)
+						w.Write(content)
+					default:
+						w.WriteHeader(http.StatusOK)
+						fmt.Fprintln(w, inspectBody)
+					}
+				})
+			defer cleanup()
+
+			conf := getTestConfiguration()
+			conf.ApiURL = url
+
+			var out bytes.Buffer
+			err := client.ImportValueMapping(&out, conf, "VM_Countries", "active", strings.NewReader(tc.csv))
+			if tc.expError != nil {
+				if err == nil {
+					t.Fatalf("Expected error %q, got no error.", tc.expError)
+				}
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			for _, exp := range tc.expOut {
+				if !strings.Contains(out.String(), exp) {
+					t.Errorf("Expected output to contain %q, got %q", exp, out.String())
+				}
+			}
+			if len(tc.expValues) == 0 {
+				if uploaded != nil {
+					t.Errorf("Expected no upload")
+				}
+				return
+			}
+
+			zr, err := zip.NewReader(bytes.NewReader(uploaded), int64(len(uploaded)))
+			if err != nil {
+				t.Fatalf("Uploaded content is not a zip: %q", err)
+			}
+			var xmlContent []byte
+			for _, f := range zr.File {
+				if f.Name == "value_mapping.xml" {
+					rc, _ := f.Open()
+					xmlContent, _ = io.ReadAll(rc)
+					rc.Close()
+				}
+			}
+			for _, v := range tc.expValues {
+				if !strings.Contains(string(xmlContent), "<value>"+v+"</value>") {
+					t.Errorf("Expected uploaded value_mapping.xml to contain %q, got:\n%s", v, xmlContent)
+				}
+			}
+			if !strings.Contains(string(xmlContent), `<group id="0001">`) {
+				t.Errorf("Expected group id 0001 to be kept, got:\n%s", xmlContent)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"io"
+	"log"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunTransportValueMapping - call the function TransportValueMapping
+func RunTransportValueMapping(out io.Writer, conf config.Configuration, srcID string, destID string, destTenantKey string, destName string, destPackageID string) {
+
+	destConf, err := config.NewConfiguration(destTenantKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = TransportValueMapping(out, conf, srcID, destConf, destID, destName, destPackageID)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+//TransportValueMapping is the function for transporting value mapping from one system to another
+func TransportValueMapping(out io.Writer, conf config.Configuration, srcID string, destConf config.Configuration, destID string, destName string, destPackageID string) error {
+	return TransportArtifact(out, conf, ValueMappingArtifact, srcID, destConf, destID, destName, destPackageID)
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"io"
+	"log"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+)
+
+//RunUpdateValueMapping - call the function UpdateValueMapping
+func RunUpdateValueMapping(out io.Writer, conf config.Configuration, name string, id string, version string, fileName string) {
+	var fileContent io.ReadCloser
+	var err error
+	if fileName != "" {
+		fileContent, err = os.Open(fileName)
+		if err != nil {
+			log.Fatal("Error Openning file:\n", err)
+		}
+		defer fileContent.Close()
+	}
+
+	err = UpdateValueMapping(out, conf, name, id, version, fileContent)
+	if err != nil {
+		log.Fatal("Error in UpdateValueMapping:\n", err)
+	}
+}
+
+//UpdateValueMapping - update value mapping name and content
+func UpdateValueMapping(out io.Writer, conf config.Configuration, name string, id string, version string, valueMappingContent io.Reader) error {
+	return UpdateArtifact(out, conf, ValueMappingArtifact, name, id, version, valueMappingContent)
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func variableURL(conf config.Configuration, variable model.Variable) string {
+	return conf.ApiURL + "/Variables(VariableName=" + odataKey(variable.VariableName) +
+		",IntegrationFlow=" + odataKey(variable.IntegrationFlow) + ")"
+}
+
+//RunGetVariables - call the function GetVariables
+func RunGetVariables(out io.Writer, conf config.Configuration) {
+	resp, err := GetVariables(conf)
+	if err != nil {
+		log.Fatal("Error in GetVariables:\n", err)
+	}
+	resp.Print(out)
+}
+
+//GetVariables - get list of the global and local variables of the tenant
+func GetVariables(conf config.Configuration) (*model.VariablesResponse, error) {
+	var decodedRes model.VariablesResponse
+	if err := getEntity(conf, conf.ApiURL+"/Variables", &decodedRes); err != nil {
+		return nil, err
+	}
+	return &decodedRes, nil
+}
+
+//FindVariable returns the variable with the name. The integration flow is needed
+//only when local variables with the same name exist for more integration flows.
+func FindVariable(conf config.Configuration, name string, flowID string) (model.Variable, error) {
+	variables, err := GetVariables(conf)
+	if err != nil {
+		return model.Variable{}, err
+	}
+
+	var found []model.Variable
+	for _, v := range variables.D.Results {
+		if v.VariableName != name {
+			continue
+		}
+		if flowID != "" && v.IntegrationFlow != flowID {
+			continue
+		}
+		found = append(found, v)
+	}
+
+	switch len(found) {
+	case 0:
+		return model.Variable{}, fmt.Errorf("%w: variable %s", ErrNotFound, name)
+	case 1:
+		return found[0], nil
+	}
+	return model.Variable{}, fmt.Errorf("%w: variable %s exists for more integration flows, use --flow flag", ErrInvalid, name)
+}
+
+//RunGetVariable - print the variable, the content is saved when saveFile is set
+func RunGetVariable(out io.Writer, conf config.Configuration, name string, flowID string, saveFile string) {
+	variable, err := FindVariable(conf, name, flowID)
+	if err != nil {
+		log.Fatal("Error in FindVariable:\n", err)
+	}
+
+	if saveFile == "" {
+		resp := model.VariableByIdResponse{D: variable}
+		resp.Print(out)
+		return
+	}
+
+	outputContent, err := os.OpenFile(saveFile, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0666)
+	if err != nil {
+		log.Fatal("Error Openning file:\n", err)
+	}
+	defer outputContent.Close()
+
+	err = DownloadVariable(out, conf, variable, outputContent)
+	if err != nil {
+		log.Fatal("Error in DownloadVariable: ", err)
+	}
+}
+
+//DownloadVariable - download the content of the variable, the content is zip archive with the value
+func DownloadVariable(out io.Writer, conf config.Configuration, variable model.Variable, outputContent io.Writer) error {
+	content, err := getEntityContent(conf, variableURL(conf, variable)+"/$value", "")
+	if err != nil {
+		return err
+	}
+
+	n, err := saveBodyContent(outputContent, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Content downloaded.\nnumber of bytes: %d\n", n)
+	return nil
+}
+
+//RunDeleteVariable - call the function DeleteVariable
+func RunDeleteVariable(out io.Writer, conf config.Configuration, name string, flowID string) {
+	variable, err := FindVariable(conf, name, flowID)
+	if err != nil {
+		log.Fatal("Error in FindVariable:\n", err)
+	}
+
+	err = DeleteVariable(out, conf, variable)
+	if err != nil {
+		log.Fatal("Error in DeleteVariable:\n", err)
+	}
+}
+
+//DeleteVariable - delete the global or local variable
+func DeleteVariable(out io.Writer, conf config.Configuration, variable model.Variable) error {
+	if err := sendEntity(conf, "DELETE", variableURL(conf, variable), nil, nil); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Variable: %s deleted\n", variable.VariableName)
+	return nil
+}
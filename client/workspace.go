@@ -0,0 +1,356 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/iflow"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+const (
+	workspacePackageFile       = "package.json"
+	workspaceConfigurationsExt = ".configurations.json"
+)
+
+//RunExportWorkspace - call the function ExportWorkspace
+func RunExportWorkspace(out io.Writer, conf config.Configuration, dir string) {
+	err := ExportWorkspace(out, conf, dir)
+	if err != nil {
+		log.Fatal("Error in ExportWorkspace: ", err)
+	}
+}
+
+//ExportWorkspace extracts all designtime artifacts of the tenant into dir/<package-id>/<artifact-id>/.
+//The package metadata is written to dir/<package-id>/package.json and the configurations of the integration flows
+//to dir/<package-id>/<flow-id>.configurations.json. Packages and artifacts deleted on the tenant are removed from dir.
+func ExportWorkspace(out io.Writer, conf config.Configuration, dir string) error {
+	packages, err := GetIntegrationPackages(conf)
+	if err != nil {
+		return err
+	}
+
+	exported := map[string]bool{}
+	for _, p := range packages.D.Results {
+		wp, err := getWorkspacePackage(conf, p)
+		if err != nil {
+			return err
+		}
+
+		packageDir := filepath.Join(dir, p.ID)
+		if err := os.MkdirAll(packageDir, os.ModePerm); err != nil {
+			return err
+		}
+
+		keep := map[string]bool{workspacePackageFile: true}
+		for _, a := range wp.Artifacts {
+			keep[a.ID] = true
+			artifactType, _ := GetArtifactType(a.Type)
+			flow, err := downloadWorkspaceArtifact(conf, artifactType, a.ID)
+			if errors.Is(err, ErrConnection) {
+				return err
+			}
+			if err != nil {
+				log.Printf("%s: %s content not exported: %s", artifactType.Description, a.ID, err)
+				continue
+			}
+			if _, err := extractFlow(flow, filepath.Join(packageDir, a.ID)); err != nil {
+				return err
+			}
+
+			if artifactType != FlowArtifact {
+				continue
+			}
+			keep[a.ID+workspaceConfigurationsExt] = true
+			configs, err := getWorkspaceConfigurations(conf, a.ID)
+			if err != nil {
+				return err
+			}
+			if err := writeJSONFile(filepath.Join(packageDir, a.ID+workspaceConfigurationsExt), configs); err != nil {
+				return err
+			}
+		}
+
+		if err := removeStaleEntries(packageDir, keep); err != nil {
+			return err
+		}
+		if err := writeJSONFile(filepath.Join(packageDir, workspacePackageFile), wp); err != nil {
+			return err
+		}
+		exported[p.ID] = true
+		fmt.Fprintf(out, "Integration package: %s exported, number of artifacts: %d\n", p.ID, len(wp.Artifacts))
+	}
+
+	localPackages, err := readWorkspacePackages(dir)
+	if err != nil {
+		return err
+	}
+	for name := range localPackages {
+		if exported[name] {
+			continue
+		}
+		log.Printf("Integration package: %s does not exist in the tenant, removing", name)
+		if err := os.RemoveAll(filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//RunGetWorkspaceStatus - call the function GetWorkspaceStatus
+func RunGetWorkspaceStatus(out io.Writer, conf config.Configuration, dir string) {
+	status, err := GetWorkspaceStatus(conf, dir)
+	if err != nil {
+		log.Fatal("Error in GetWorkspaceStatus: ", err)
+	}
+	status.Print(out)
+}
+
+//GetWorkspaceStatus compares the workspace directory created by ExportWorkspace with the tenant.
+//The artifact is modified when the version, the content or the configurations differ,
+//added when it exists only in the tenant and deleted when it exists only in the directory.
+func GetWorkspaceStatus(conf config.Configuration, dir string) (*model.WorkspaceStatus, error) {
+	packages, err := GetIntegrationPackages(conf)
+	if err != nil {
+		return nil, err
+	}
+	localPackages, err := readWorkspacePackages(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &model.WorkspaceStatus{}
+	remotePackages := map[string]bool{}
+	for _, p := range packages.D.Results {
+		remotePackages[p.ID] = true
+		remote, err := getWorkspacePackage(conf, p)
+		if err != nil {
+			return nil, err
+		}
+		local := localPackages[p.ID]
+		if local == nil {
+			status.Changes = append(status.Changes, model.WorkspaceChange{Status: "added", PackageID: p.ID, Type: "package"})
+			local = &model.WorkspacePackage{}
+		}
+
+		for _, a := range remote.Artifacts {
+			localArtifact := local.Get(a.Type, a.ID)
+			if localArtifact == nil {
+				status.Changes = append(status.Changes, model.WorkspaceChange{Status: "added", PackageID: p.ID, Type: a.Type, ID: a.ID})
+				continue
+			}
+			details, err := compareWorkspaceArtifact(conf, a, *localArtifact, filepath.Join(dir, p.ID))
+			if err != nil {
+				return nil, err
+			}
+			if len(details) > 0 {
+				status.Changes = append(status.Changes, model.WorkspaceChange{Status: "modified", PackageID: p.ID, Type: a.Type, ID: a.ID,
+					Detail: strings.Join(details, "; ")})
+			}
+		}
+		for _, a := range local.Artifacts {
+			if remote.Get(a.Type, a.ID) == nil {
+				status.Changes = append(status.Changes, model.WorkspaceChange{Status: "deleted", PackageID: p.ID, Type: a.Type, ID: a.ID})
+			}
+		}
+	}
+
+	var deletedPackages []string
+	for id := range localPackages {
+		if !remotePackages[id] {
+			deletedPackages = append(deletedPackages, id)
+		}
+	}
+	sort.Strings(deletedPackages)
+	for _, id := range deletedPackages {
+		status.Changes = append(status.Changes, model.WorkspaceChange{Status: "deleted", PackageID: id, Type: "package"})
+	}
+	return status, nil
+}
+
+//getWorkspacePackage lists the artifacts of all types of the package
+func getWorkspacePackage(conf config.Configuration, p model.IntegrationPackage) (*model.WorkspacePackage, error) {
+	wp := &model.WorkspacePackage{Package: p}
+	for _, artifactType := range ArtifactTypes {
+		resp, err := GetArtifactsOfIntegrationPackage(conf, artifactType, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range resp.D.Results {
+			wp.Artifacts = append(wp.Artifacts, model.WorkspaceArtifact{Type: artifactType.Name, ID: a.ID, Version: a.Version, Name: a.Name})
+		}
+	}
+	return wp, nil
+}
+
+func downloadWorkspaceArtifact(conf config.Configuration, artifactType ArtifactType, id string) (*iflow.Flow, error) {
+	var content bytes.Buffer
+	if err := DownloadArtifact(io.Discard, conf, artifactType, id, "active", &content); err != nil {
+		return nil, err
+	}
+	return iflow.Read(content.Bytes())
+}
+
+func getWorkspaceConfigurations(conf config.Configuration, flowID string) ([]model.FlowConfigurationPrinter, error) {
+	resp, err := GetFlowConfigs(conf, flowID, "active")
+	if err != nil {
+		return nil, err
+	}
+	configs := []model.FlowConfigurationPrinter{}
+	for _, c := range resp.D.Results {
+		configs = append(configs, model.FlowConfigurationPrinter{ParameterKey: c.ParameterKey, ParameterValue: c.ParameterValue, DataType: c.DataType})
+	}
+	return configs, nil
+}
+
+//compareWorkspaceArtifact returns the descriptions of the differences between the artifact in the tenant and in the package directory
+func compareWorkspaceArtifact(conf config.Configuration, remote model.WorkspaceArtifact, local model.WorkspaceArtifact, packageDir string) ([]string, error) {
+	var details []string
+	if remote.Version != local.Version {
+		details = append(details, "version "+local.Version+" -> "+remote.Version)
+	}
+
+	artifactType, _ := GetArtifactType(remote.Type)
+	flow, err := downloadWorkspaceArtifact(conf, artifactType, remote.ID)
+	if errors.Is(err, ErrConnection) {
+		return nil, err
+	}
+	if err != nil {
+		log.Printf("%s: %s content not compared: %s", artifactType.Description, remote.ID, err)
+		return details, nil
+	}
+
+	localFiles, err := readDirFiles(filepath.Join(packageDir, remote.ID))
+	if err != nil {
+		return nil, err
+	}
+	if changed := changedFiles(flowFiles(flow), localFiles); len(changed) > 0 {
+		if len(changed) > 3 {
+			changed = append(changed[:3], "...")
+		}
+		details = append(details, "files "+strings.Join(changed, ", "))
+	}
+
+	if artifactType != FlowArtifact {
+		return details, nil
+	}
+	configs, err := getWorkspaceConfigurations(conf, remote.ID)
+	if err != nil {
+		return nil, err
+	}
+	remoteConfigs, _ := json.MarshalIndent(configs, "", "\t")
+	localConfigs, _ := os.ReadFile(filepath.Join(packageDir, remote.ID+workspaceConfigurationsExt))
+	if string(remoteConfigs) != strings.TrimSuffix(string(localConfigs), "\n") {
+		details = append(details, "configurations")
+	}
+	return details, nil
+}
+
+//flowFiles returns the normalized content of the files of the archive like extracted by extractFlow
+func flowFiles(flow *iflow.Flow) map[string][]byte {
+	files := map[string][]byte{}
+	for _, f := range flow.Files {
+		if !f.IsDir() {
+			files[f.Name] = normalizeFlowFile(f.Name, f.Content)
+		}
+	}
+	return files
+}
+
+//readDirFiles returns the content of the files of the flow in the directory by the name in the archive
+func readDirFiles(dir string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := walkFlowDir(dir, func(name string, path string, d fs.DirEntry) error {
+		var err error
+		files[name], err = os.ReadFile(path)
+		return err
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return files, nil
+	}
+	return files, err
+}
+
+//changedFiles returns the sorted names of the files which differ or exist only on one side
+func changedFiles(a map[string][]byte, b map[string][]byte) []string {
+	var changed []string
+	for name, content := range a {
+		if other, ok := b[name]; !ok || !bytes.Equal(content, other) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+//readWorkspacePackages reads package.json of the package directories by the name of the directory,
+//which is the package id when the directory was written by ExportWorkspace
+func readWorkspacePackages(dir string) (map[string]*model.WorkspacePackage, error) {
+	packages := map[string]*model.WorkspacePackage{}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return packages, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, e.Name(), workspacePackageFile))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var p model.WorkspacePackage
+		if err := json.Unmarshal(content, &p); err != nil {
+			return nil, fmt.Errorf("%w: %s: %s", ErrInvalid, filepath.Join(e.Name(), workspacePackageFile), err)
+		}
+		packages[e.Name()] = &p
+	}
+	return packages, nil
+}
+
+//removeStaleEntries removes the files and directories of the package directory which are not kept, hidden entries are skipped
+func removeStaleEntries(dir string, keep map[string]bool) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if keep[e.Name()] || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		log.Printf("%s does not exist in the tenant, removing", filepath.Join(dir, e.Name()))
+		if err := os.RemoveAll(filepath.Join(dir, e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeJSONFile(fileName string, v interface{}) error {
+	content, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, append(content, '\n'), 0666)
+}
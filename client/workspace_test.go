@@ -0,0 +1,109 @@
+package client_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+func TestWorkspace(t *testing.T) {
+	flowVersion := "1.0.0"
+	flowParameter := "sftp.example.com"
+	flowContent := newTestArtifactZip(t, "PurchaseOrder", map[string]string{
+		"src/main/resources/script/script1.groovy": "def Message processData(Message message) {\r\n}\r\n",
+	})
+	url, cleanup := mockServer(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/IntegrationPackages":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, `{"d": {"results": [{"Id": "POscenerio", "Name": "Purchase Orders", "Version": "1.0.0"}]}}`)
+			case "/IntegrationPackages('POscenerio')/IntegrationDesigntimeArtifacts":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, `{"d": {"results": [{"Id": "PurchaseOrder", "Name": "Purchase Order", "Version": "%s", "PackageId": "POscenerio"}]}}`, flowVersion)
+			case "/IntegrationDesigntimeArtifacts(Id='PurchaseOrder',Version='active')/$value":
+				w.WriteHeader(http.StatusOK)
+				w.Write(flowContent)
+			case "/IntegrationDesigntimeArtifacts(Id='PurchaseOrder',Version='active')/Configurations":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, `{"d": {"results": [{"ParameterKey": "SFTP_Host", "ParameterValue": "%s", "DataType": "xsd:string"}]}}`, flowParameter)
+			default:
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, `{"d": {"results": []}}`)
+			}
+		})
+	defer cleanup()
+
+	conf := getTestConfiguration()
+	conf.ApiURL = url
+
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "Removed"), os.ModePerm)
+	os.WriteFile(filepath.Join(dir, "Removed", "package.json"), []byte(`{"Package": {"Id": "Removed"}}`), 0666)
+	os.MkdirAll(filepath.Join(dir, "Renamed"), os.ModePerm)
+	os.WriteFile(filepath.Join(dir, "Renamed", "package.json"), []byte(`{"Package": {"Id": "Notes"}}`), 0666)
+	os.MkdirAll(filepath.Join(dir, "Notes"), os.ModePerm)
+	os.WriteFile(filepath.Join(dir, "Notes", "todo.txt"), []byte("todo\n"), 0666)
+
+	var out bytes.Buffer
+	if err := client.ExportWorkspace(&out, conf, dir); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	for _, name := range []string{
+		"POscenerio/package.json",
+		"POscenerio/PurchaseOrder.configurations.json",
+		"POscenerio/PurchaseOrder/META-INF/MANIFEST.MF",
+		"POscenerio/PurchaseOrder/src/main/resources/script/script1.groovy",
+	} {
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+			t.Errorf("Expected file %s, got %q", name, err)
+		}
+	}
+	for _, name := range []string{"Removed", "Renamed"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Errorf("Package directory %s should be removed", name)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "Notes", "todo.txt")); err != nil {
+		t.Errorf("Directory Notes without package.json should be kept: %s", err)
+	}
+
+	flowDir := filepath.Join(dir, "POscenerio", "PurchaseOrder")
+	os.WriteFile(filepath.Join(flowDir, "README.md"), []byte("# Purchase Order\n"), 0666)
+	os.WriteFile(filepath.Join(flowDir, ".gitignore"), []byte("*.zip\n"), 0666)
+
+	status, err := client.GetWorkspaceStatus(conf, dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if len(status.Changes) != 0 {
+		t.Errorf("Expected no changes after export, got %+v", status.Changes)
+	}
+
+	flowVersion = "1.0.1"
+	flowParameter = "sftp.partner.com"
+	os.WriteFile(filepath.Join(dir, "POscenerio", "PurchaseOrder", "src", "main", "resources", "script", "script1.groovy"), []byte("changed"), 0666)
+
+	status, err = client.GetWorkspaceStatus(conf, dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	expChanges := []model.WorkspaceChange{{
+		Status:    "modified",
+		PackageID: "POscenerio",
+		Type:      "flow",
+		ID:        "PurchaseOrder",
+		Detail:    "version 1.0.0 -> 1.0.1; files src/main/resources/script/script1.groovy; configurations",
+	}}
+	if !reflect.DeepEqual(status.Changes, expChanges) {
+		t.Errorf("Expected changes %+v, got %+v", expChanges, status.Changes)
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// applyCmd represents the apply command
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Bring the tenant to the state of the state file",
+	Long: `You can use the following command to execute the changes computed by plan in the dependency order:
+credentials, packages, integration flows, configurations and deployments.
+The execution stops on the first error and the final report lists the result of every change.
+See cig plan --help for the format of the state file.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fileName, _ := cmd.Flags().GetString("file")
+		client.RunApplyState(os.Stdout, conf, fileName)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+	applyCmd.Flags().StringP("file", "f", "cig.yaml", "State file in yaml or json format")
+}
@@ -0,0 +1,35 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+)
+
+// artifactCmd represents the artifact command
+var artifactCmd = &cobra.Command{
+	Use:     "artifact",
+	Aliases: []string{"a"},
+	Short:   "Command related to the processing of designtime artifacts of any type",
+	Long: `Command related to the processing of designtime artifacts of any type.
+The artifact type is selected with the --type flag: flow, valuemapping, messagemapping, scriptcollection.`,
+}
+
+func init() {
+	rootCmd.AddCommand(artifactCmd)
+	artifactCmd.PersistentFlags().StringP("type", "y", "flow", "Artifact type. Available values: flow, valuemapping, messagemapping, scriptcollection")
+}
+
+func getArtifactTypeFlag(cmd *cobra.Command) client.ArtifactType {
+	typeName, _ := cmd.Flags().GetString("type")
+	artifactType, err := client.GetArtifactType(typeName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return artifactType
+}
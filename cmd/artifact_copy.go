@@ -0,0 +1,45 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// artifactCopyCmd represents the artifactCopy command
+var artifactCopyCmd = &cobra.Command{
+	Use:   "copy [source-artifact-id] [destination-artifact-id]",
+	Short: "Copy an artifact",
+	Long: `You can use the following subcommand to copy
+a designtime artifact. `,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter source-artifact-id not set")
+		}
+		if len(args) == 1 {
+			log.Fatal("Required parameter destination-artifact-id not set")
+		}
+		artifactType := getArtifactTypeFlag(cmd)
+		destName, _ := cmd.Flags().GetString("dest-name")
+		destPackageID, _ := cmd.Flags().GetString("dest-package-id")
+
+		client.RunCopyArtifact(os.Stdout, conf, artifactType, args[0], args[1], destName, destPackageID)
+	},
+}
+
+func init() {
+	artifactCmd.AddCommand(artifactCopyCmd)
+	artifactCopyCmd.Flags().StringP("dest-name", "n", "", "Destination artifact name")
+	artifactCopyCmd.Flags().StringP("dest-package-id", "p", "", "Destination artifact package id")
+}
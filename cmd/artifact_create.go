@@ -0,0 +1,44 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// artifactCreateCmd represents the artifactCreate command
+var artifactCreateCmd = &cobra.Command{
+	Use:     "create",
+	Aliases: []string{"upload"},
+	Short:   "Create or upload an artifact",
+	Long:    `You can use the following subcommand to create or upload a designtime artifact`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		artifactType := getArtifactTypeFlag(cmd)
+		name, _ := cmd.Flags().GetString("name")
+		id, _ := cmd.Flags().GetString("id")
+		packageid, _ := cmd.Flags().GetString("package-id")
+		fileName, _ := cmd.Flags().GetString("content-file-name")
+
+		client.RunCreateArtifact(os.Stdout, conf, artifactType, name, id, packageid, fileName)
+	},
+}
+
+func init() {
+	artifactCmd.AddCommand(artifactCreateCmd)
+	artifactCreateCmd.Flags().StringP("name", "n", "", "Artifact name")
+	artifactCreateCmd.Flags().StringP("id", "i", "", "Artifact id")
+	artifactCreateCmd.Flags().StringP("package-id", "p", "", "Artifact package id")
+	artifactCreateCmd.Flags().StringP("content-file-name", "f", "", "Artifact content file (.zip)")
+}
@@ -0,0 +1,39 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// artifactDeployCmd represents the artifactDeploy command
+var artifactDeployCmd = &cobra.Command{
+	Use:   "deploy artifact-id",
+	Short: "Deploy an artifact",
+	Long:  `You can use the following request to deploy a designtime artifact.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			log.Fatal("Required parameter artifact-id not set")
+		}
+		artifactType := getArtifactTypeFlag(cmd)
+		version, _ := cmd.Flags().GetString("version")
+		client.RunDeployArtifact(os.Stdout, conf, artifactType, args[0], version)
+	},
+}
+
+func init() {
+	artifactCmd.AddCommand(artifactDeployCmd)
+	artifactDeployCmd.Flags().StringP("version", "v", "active", "Artifact version")
+}
@@ -0,0 +1,40 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// artifactDownloadCmd represents the artifactDownload command
+var artifactDownloadCmd = &cobra.Command{
+	Use:   "download artifact-id",
+	Short: "Download an artifact as zip file",
+	Long:  `You can use the following subcommand to download a designtime artifact as zip file.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter artifact-id not set")
+		}
+		artifactType := getArtifactTypeFlag(cmd)
+		fileName, _ := cmd.Flags().GetString("output-file")
+		version, _ := cmd.Flags().GetString("version")
+		client.RunDownloadArtifact(os.Stdout, conf, artifactType, args[0], version, fileName)
+	},
+}
+
+func init() {
+	artifactCmd.AddCommand(artifactDownloadCmd)
+	artifactDownloadCmd.Flags().StringP("output-file", "o", "", "The output file with artifact [default value artifactId.zip]")
+	artifactDownloadCmd.Flags().StringP("version", "v", "active", "Artifact version")
+}
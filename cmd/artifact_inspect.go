@@ -0,0 +1,38 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// artifactInspectCmd represents the artifactInspect command
+var artifactInspectCmd = &cobra.Command{
+	Use:   "inspect artifact-id",
+	Short: "Get artifact by id and version",
+	Long:  `You can use the following subcommand to get a designtime artifact by Id and version.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter artifact-id not set")
+		}
+		artifactType := getArtifactTypeFlag(cmd)
+		version, _ := cmd.Flags().GetString("version")
+		client.RunInspectArtifact(os.Stdout, conf, artifactType, args[0], version)
+	},
+}
+
+func init() {
+	artifactCmd.AddCommand(artifactInspectCmd)
+	artifactInspectCmd.Flags().StringP("version", "v", "active", "Artifact version")
+}
@@ -0,0 +1,36 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// artifactLsCmd represents the artifactLs command
+var artifactLsCmd = &cobra.Command{
+	Use:   "ls package-id",
+	Short: "Get all artifacts of given type of the integration package",
+	Long:  `You can use the following subcommand to get all designtime artifacts of given type of the specified package-id.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter package-id not set")
+		}
+		artifactType := getArtifactTypeFlag(cmd)
+		client.RunGetArtifactsOfIntegrationPackage(os.Stdout, conf, artifactType, args[0])
+	},
+}
+
+func init() {
+	artifactCmd.AddCommand(artifactLsCmd)
+}
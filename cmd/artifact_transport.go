@@ -0,0 +1,52 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// artifactTransportCmd represents the artifactTransport command
+var artifactTransportCmd = &cobra.Command{
+	Use:   "transport [source-artifact-id] [destination-artifact-id]",
+	Short: "Transport an artifact between systems",
+	Long: `You can use the following subcommand to transport
+a designtime artifact between systems. `,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			log.Fatal("Required parameter source-artifact-id not set")
+		}
+		if len(args) == 1 {
+			log.Fatal("Required parameter destination-artifact-id not set")
+		}
+		destTenantKey, _ := cmd.Flags().GetString("dest-tenant-key")
+		if destTenantKey == "" {
+			log.Fatal("Required flag dest-tenant-key not set")
+		}
+
+		artifactType := getArtifactTypeFlag(cmd)
+		destName, _ := cmd.Flags().GetString("dest-name")
+		destPackageID, _ := cmd.Flags().GetString("dest-package-id")
+
+		client.RunTransportArtifact(os.Stdout, conf, artifactType, args[0], args[1], destTenantKey, destName, destPackageID)
+	},
+}
+
+func init() {
+	artifactCmd.AddCommand(artifactTransportCmd)
+	artifactTransportCmd.Flags().StringP("dest-tenant-key", "d", "", "Destination tenant key from configuration file")
+	artifactTransportCmd.Flags().StringP("dest-name", "n", "", "Destination artifact name")
+	artifactTransportCmd.Flags().StringP("dest-package-id", "p", "", "Destination artifact package id")
+}
@@ -0,0 +1,43 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// artifactUpdateCmd represents the artifactUpdate command
+var artifactUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update an artifact",
+	Long:  `You can use the following command to update a designtime artifact`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		artifactType := getArtifactTypeFlag(cmd)
+		name, _ := cmd.Flags().GetString("name")
+		id, _ := cmd.Flags().GetString("id")
+		fileName, _ := cmd.Flags().GetString("content-file-name")
+		version, _ := cmd.Flags().GetString("version")
+
+		client.RunUpdateArtifact(os.Stdout, conf, artifactType, name, id, version, fileName)
+	},
+}
+
+func init() {
+	artifactCmd.AddCommand(artifactUpdateCmd)
+	artifactUpdateCmd.Flags().StringP("name", "n", "", "Artifact name")
+	artifactUpdateCmd.Flags().StringP("id", "i", "", "Artifact id")
+	artifactUpdateCmd.Flags().StringP("version", "v", "active", "Artifact version")
+	artifactUpdateCmd.Flags().StringP("content-file-name", "f", "", "Artifact content file (.zip)")
+}
@@ -0,0 +1,20 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// datastoreCmd represents the datastore command
+var datastoreCmd = &cobra.Command{
+	Use:     "datastore",
+	Aliases: []string{"ds"},
+	Short:   "Command related to the data stores of the runtime",
+}
+
+func init() {
+	rootCmd.AddCommand(datastoreCmd)
+}
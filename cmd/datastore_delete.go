@@ -0,0 +1,44 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// datastoreDeleteCmd represents the delete command
+var datastoreDeleteCmd = &cobra.Command{
+	Use:   "delete store [entry-id]",
+	Short: "Delete entry of the data store or the whole data store",
+	Long: `You can use the following command to delete an entry of the data store.
+The data store with all its entries is deleted only with the --all flag and without the entry id.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter store not set")
+		}
+		flowID, _ := cmd.Flags().GetString("flow")
+		entryID := ""
+		if len(args) > 1 {
+			entryID = args[1]
+		}
+		all, _ := cmd.Flags().GetBool("all")
+		client.RunDeleteDataStore(os.Stdout, conf, args[0], flowID, entryID, all)
+	},
+}
+
+func init() {
+	datastoreCmd.AddCommand(datastoreDeleteCmd)
+	datastoreDeleteCmd.Flags().StringP("flow", "i", "", "Integration flow id of the local data store (needed when the name is not unique)")
+	datastoreDeleteCmd.Flags().Bool("all", false, "Delete the data store with all its entries")
+}
@@ -0,0 +1,36 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// datastoreEntriesCmd represents the entries command
+var datastoreEntriesCmd = &cobra.Command{
+	Use:   "entries store",
+	Short: "Get entries of the data store",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter store not set")
+		}
+		flowID, _ := cmd.Flags().GetString("flow")
+		client.RunGetDataStoreEntries(os.Stdout, conf, args[0], flowID)
+	},
+}
+
+func init() {
+	datastoreCmd.AddCommand(datastoreEntriesCmd)
+	datastoreEntriesCmd.Flags().StringP("flow", "i", "", "Integration flow id of the local data store (needed when the name is not unique)")
+}
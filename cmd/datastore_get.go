@@ -0,0 +1,43 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// datastoreGetCmd represents the get command
+var datastoreGetCmd = &cobra.Command{
+	Use:   "get store entry-id",
+	Short: "Get entry of the data store",
+	Long: `You can use the following command to get an entry of the data store.
+With the --save flag the payload of the entry is downloaded as zip archive with body and headers.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter store not set")
+		}
+		if len(args) < 2 {
+			log.Fatal("Required parameter entry-id not set")
+		}
+		flowID, _ := cmd.Flags().GetString("flow")
+		saveFile, _ := cmd.Flags().GetString("save")
+		client.RunGetDataStoreEntry(os.Stdout, conf, args[0], flowID, args[1], saveFile)
+	},
+}
+
+func init() {
+	datastoreCmd.AddCommand(datastoreGetCmd)
+	datastoreGetCmd.Flags().StringP("flow", "i", "", "Integration flow id of the local data store (needed when the name is not unique)")
+	datastoreGetCmd.Flags().StringP("save", "s", "", "Download the payload of the entry (zip archive) to the file")
+}
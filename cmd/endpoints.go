@@ -0,0 +1,19 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// endpointsCmd represents the endpoints command
+var endpointsCmd = &cobra.Command{
+	Use:   "endpoints",
+	Short: "Command related to the service endpoints of the deployed integration flows",
+}
+
+func init() {
+	rootCmd.AddCommand(endpointsCmd)
+}
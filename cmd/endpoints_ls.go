@@ -0,0 +1,33 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// endpointsLsCmd represents the ls command
+var endpointsLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "Get service endpoints with entry point URLs and definitions",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		flowID, _ := cmd.Flags().GetString("flow")
+		client.RunGetServiceEndpoints(os.Stdout, conf, flowID)
+	},
+}
+
+func init() {
+	endpointsCmd.AddCommand(endpointsLsCmd)
+	endpointsLsCmd.Flags().StringP("flow", "i", "", "Integration flow id")
+}
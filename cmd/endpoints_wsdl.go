@@ -0,0 +1,39 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// endpointsWsdlCmd represents the wsdl command
+var endpointsWsdlCmd = &cobra.Command{
+	Use:   "wsdl endpoint-id",
+	Short: "Download WSDL or API definition of the service endpoint",
+	Long: `You can use the following command to download the WSDL or API definition of the service endpoint,
+the endpoint id is listed by the ls command, e.g.:
+cig endpoints wsdl 'MyFlow$endpointAddress=orders' -o orders.wsdl`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter endpoint-id not set")
+		}
+		outputFile, _ := cmd.Flags().GetString("output-file")
+		client.RunDownloadServiceEndpointDefinition(os.Stdout, conf, args[0], outputFile)
+	},
+}
+
+func init() {
+	endpointsCmd.AddCommand(endpointsWsdlCmd)
+	endpointsWsdlCmd.Flags().StringP("output-file", "o", "", "Output file name (default standard output)")
+}
@@ -0,0 +1,62 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// flowLintCmd represents the lint command
+var flowLintCmd = &cobra.Command{
+	Use:   "lint flow-id|zip|dir",
+	Short: "Check an integration flow against the rule set",
+	Long: `You can use the following command to check the integration flow against the rule set:
+- hardcoded-url         channels must not contain hard-coded URLs
+- externalize-address   addresses of the receiver channels must be externalized
+- script-log-payload    Groovy scripts must not log the payload
+- flow-id-naming        id of the integration flow must match the pattern
+- undefined-parameter   parameters referenced as {{parameter}} must be defined in parameters.propdef
+- unused-parameter      parameters of parameters.propdef must be referenced in the integration flow
+- parameter-type        values of the parameters must match the type of the definition
+The flow is read from the directory created by flow pull, from the zip file or downloaded from the tenant.
+The rules can be disabled or configured in the yaml or json file, e.g.:
+rules:
+  flow-id-naming:
+    severity: error
+    pattern: "^[A-Z][A-Za-z0-9_]+$"
+  externalize-address:
+    properties: [address, host]
+  script-log-payload:
+    enabled: false
+The command exits with non-zero status when there are findings with the severity --fail-on or higher, e.g.:
+cig flow lint flows/PurchaseOrder --format sarif -o lint.sarif`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			log.Fatal("Required parameter flow-id|zip|dir not set")
+		}
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil && !client.IsLocalFlowSource(args[0]) {
+			log.Fatal(err)
+		}
+		configFile, _ := cmd.Flags().GetString("config")
+		format, _ := cmd.Flags().GetString("format")
+		failOn, _ := cmd.Flags().GetString("fail-on")
+		outputFile, _ := cmd.Flags().GetString("output-file")
+		client.RunLintFlow(os.Stdout, conf, args[0], configFile, format, failOn, outputFile)
+	},
+}
+
+func init() {
+	flowCmd.AddCommand(flowLintCmd)
+	flowLintCmd.Flags().StringP("config", "c", "", "Configuration of the rule set in yaml or json format")
+	flowLintCmd.Flags().StringP("format", "f", "text", "Output format. Available values: text, json, sarif")
+	flowLintCmd.Flags().String("fail-on", "error", "Exit with non-zero status on findings with the severity or higher. Available values: error, warning, info, none")
+	flowLintCmd.Flags().StringP("output-file", "o", "", "The output file with the findings [default stdout]")
+}
@@ -0,0 +1,51 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// flowParamsCheckCmd represents the params-check command
+var flowParamsCheckCmd = &cobra.Command{
+	Use:   "params-check flow-id|zip|dir",
+	Short: "Check externalized parameters of an integration flow",
+	Long: `You can use the following command to cross-reference the {{parameter}} placeholders of the integration flow,
+parameters.prop, parameters.propdef and the configuration parameters of the flow in the tenant:
+- undefined-parameter   parameter is referenced but not defined in parameters.propdef or not configured in the tenant
+- unused-parameter      parameter is defined or configured but not referenced in the integration flow
+- parameter-type        type of the definition differs from the tenant or the value does not match the type
+The flow is read from the directory created by flow pull, from the zip file or downloaded from the tenant.
+The command exits with non-zero status when there are findings with the severity --fail-on or higher, e.g.:
+cig flow params-check flows/PurchaseOrder
+cig flow params-check PurchaseOrder.zip --local`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			log.Fatal("Required parameter flow-id|zip|dir not set")
+		}
+		local, _ := cmd.Flags().GetBool("local")
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil && !(local && client.IsLocalFlowSource(args[0])) {
+			log.Fatal(err)
+		}
+		version, _ := cmd.Flags().GetString("version")
+		format, _ := cmd.Flags().GetString("format")
+		failOn, _ := cmd.Flags().GetString("fail-on")
+		client.RunCheckFlowParameters(os.Stdout, conf, args[0], version, local, format, failOn)
+	},
+}
+
+func init() {
+	flowCmd.AddCommand(flowParamsCheckCmd)
+	flowParamsCheckCmd.Flags().StringP("version", "v", "active", "Version of the flow in the tenant")
+	flowParamsCheckCmd.Flags().Bool("local", false, "Check only the files of the flow without the configuration of the tenant")
+	flowParamsCheckCmd.Flags().StringP("format", "f", "text", "Output format. Available values: text, json, sarif")
+	flowParamsCheckCmd.Flags().String("fail-on", "error", "Exit with non-zero status on findings with the severity or higher. Available values: error, warning, info, none")
+}
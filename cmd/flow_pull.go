@@ -0,0 +1,45 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// flowPullCmd represents the pull command
+var flowPullCmd = &cobra.Command{
+	Use:   "pull flow-id [dir]",
+	Short: "Download an integration flow and extract it into the directory",
+	Long: `You can use the following command to download an integration flow and extract it
+into the directory [default value flow-id], so the flow can be reviewed and versioned as plain files.
+Line endings are normalized to LF and the timestamp comments of the properties files are removed.
+Files of the directory which are no longer part of the flow are removed, e.g.:
+cig flow pull PurchaseOrder flows/PurchaseOrder`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter flow-id not set")
+		}
+		dir := ""
+		if len(args) > 1 {
+			dir = args[1]
+		}
+		version, _ := cmd.Flags().GetString("version")
+		client.RunPullFlow(os.Stdout, conf, args[0], version, dir)
+	},
+}
+
+func init() {
+	flowCmd.AddCommand(flowPullCmd)
+	flowPullCmd.Flags().StringP("version", "v", "active", "Integration Flow version")
+}
@@ -0,0 +1,40 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// flowPushCmd represents the push command
+var flowPushCmd = &cobra.Command{
+	Use:   "push dir",
+	Short: "Zip the directory and upload it as integration flow",
+	Long: `You can use the following command to zip the directory created by flow pull
+and upload it to the tenant. The flow id is taken from Bundle-SymbolicName of META-INF/MANIFEST.MF.
+The flow is updated when it exists, otherwise it is created in the package, e.g.:
+cig flow push flows/PurchaseOrder --package-id POscenerio`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter dir not set")
+		}
+		packageID, _ := cmd.Flags().GetString("package-id")
+		client.RunPushFlow(os.Stdout, conf, args[0], packageID)
+	},
+}
+
+func init() {
+	flowCmd.AddCommand(flowPushCmd)
+	flowPushCmd.Flags().StringP("package-id", "p", "", "Integration package id, required when the flow does not exist")
+}
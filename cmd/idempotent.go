@@ -0,0 +1,20 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// idempotentCmd represents the idempotent command
+var idempotentCmd = &cobra.Command{
+	Use:     "idempotent",
+	Aliases: []string{"idem"},
+	Short:   "Command related to the idempotent repository entries of the runtime",
+}
+
+func init() {
+	rootCmd.AddCommand(idempotentCmd)
+}
@@ -0,0 +1,37 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// idempotentDeleteCmd represents the delete command
+var idempotentDeleteCmd = &cobra.Command{
+	Use:   "delete source entry",
+	Short: "Delete idempotent repository entry to allow reprocessing",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter source not set")
+		}
+		if len(args) < 2 {
+			log.Fatal("Required parameter entry not set")
+		}
+		client.RunDeleteIdempotentRepositoryEntry(os.Stdout, conf, args[0], args[1])
+	},
+}
+
+func init() {
+	idempotentCmd.AddCommand(idempotentDeleteCmd)
+}
@@ -0,0 +1,37 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// idempotentLsCmd represents the ls command
+var idempotentLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "Search idempotent repository entries",
+	Long: `You can use the following command to search idempotent repository entries by source and entry id,
+e.g. to find out why a message was skipped as duplicate.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		source, _ := cmd.Flags().GetString("source")
+		entry, _ := cmd.Flags().GetString("entry")
+		client.RunGetIdempotentRepositoryEntries(os.Stdout, conf, source, entry)
+	},
+}
+
+func init() {
+	idempotentCmd.AddCommand(idempotentLsCmd)
+	idempotentLsCmd.Flags().String("source", "", "Source of the entry, e.g. SFTP directory or flow id")
+	idempotentLsCmd.Flags().String("entry", "", "Entry id, e.g. file name or message id")
+}
@@ -0,0 +1,19 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// jmsCmd represents the jms command
+var jmsCmd = &cobra.Command{
+	Use:   "jms",
+	Short: "Command related to the JMS queues of the runtime",
+}
+
+func init() {
+	rootCmd.AddCommand(jmsCmd)
+}
@@ -0,0 +1,37 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// jmsDeleteCmd represents the delete command
+var jmsDeleteCmd = &cobra.Command{
+	Use:   "delete queue msgid",
+	Short: "Delete message from the JMS queue",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter queue not set")
+		}
+		if len(args) < 2 {
+			log.Fatal("Required parameter msgid not set")
+		}
+		client.RunDeleteJmsMessage(os.Stdout, conf, args[0], args[1])
+	},
+}
+
+func init() {
+	jmsCmd.AddCommand(jmsDeleteCmd)
+}
@@ -0,0 +1,34 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// jmsMessagesCmd represents the messages command
+var jmsMessagesCmd = &cobra.Command{
+	Use:   "messages queue",
+	Short: "Get messages of the JMS queue",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter queue not set")
+		}
+		client.RunGetJmsMessages(os.Stdout, conf, args[0])
+	},
+}
+
+func init() {
+	jmsCmd.AddCommand(jmsMessagesCmd)
+}
@@ -0,0 +1,35 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// jmsQueuesCmd represents the queues command
+var jmsQueuesCmd = &cobra.Command{
+	Use:   "queues",
+	Short: "Get all JMS queues with capacity usage",
+	Long: `You can use the following command to get all JMS queues with state, number of entries and capacity usage.
+With the --threshold flag the command exits with non-zero status when any queue is near capacity, so it can be used for alerting.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		threshold, _ := cmd.Flags().GetFloat64("threshold")
+		client.RunGetQueues(os.Stdout, conf, threshold)
+	},
+}
+
+func init() {
+	jmsCmd.AddCommand(jmsQueuesCmd)
+	jmsQueuesCmd.Flags().Float64("threshold", 0, "Exit with non-zero status when capacity usage of any queue is at least the percentage (default disabled)")
+}
@@ -0,0 +1,19 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// keystoreCmd represents the keystore command
+var keystoreCmd = &cobra.Command{
+	Use:   "keystore",
+	Short: "Command related to the entries of the tenant keystore",
+}
+
+func init() {
+	rootCmd.AddCommand(keystoreCmd)
+}
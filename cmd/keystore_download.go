@@ -0,0 +1,36 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// keystoreDownloadCmd represents the keystore download command
+var keystoreDownloadCmd = &cobra.Command{
+	Use:   "download alias",
+	Short: "Download certificate of the keystore entry",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter alias not set")
+		}
+		outputFile, _ := cmd.Flags().GetString("output-file")
+		client.RunDownloadKeystoreCertificate(os.Stdout, conf, args[0], outputFile)
+	},
+}
+
+func init() {
+	keystoreCmd.AddCommand(keystoreDownloadCmd)
+	keystoreDownloadCmd.Flags().StringP("output-file", "o", "", "Output file name (default alias.cer)")
+}
@@ -0,0 +1,35 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// keystoreExpiringCmd represents the keystore expiring command
+var keystoreExpiringCmd = &cobra.Command{
+	Use:   "expiring",
+	Short: "Get keystore entries which expire soon",
+	Long: `You can use the following command to get keystore entries which expire within the given number of days.
+The command exits with non-zero status when any entry is found, so it can be used for alerting.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		days, _ := cmd.Flags().GetInt("days")
+		client.RunGetExpiringKeystoreEntries(os.Stdout, conf, days)
+	},
+}
+
+func init() {
+	keystoreCmd.AddCommand(keystoreExpiringCmd)
+	keystoreExpiringCmd.Flags().IntP("days", "d", 30, "Number of days")
+}
@@ -0,0 +1,32 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// keystoreLsCmd represents the keystore ls command
+var keystoreLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "Get all keystore entries",
+	Long:  `You can use the following command to get all entries of the tenant keystore with alias, type, subject, issuer and expiry date.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		client.RunGetKeystoreEntries(os.Stdout, conf)
+	},
+}
+
+func init() {
+	keystoreCmd.AddCommand(keystoreLsCmd)
+}
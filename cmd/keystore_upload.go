@@ -0,0 +1,52 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// keystoreUploadCmd represents the keystore upload command
+var keystoreUploadCmd = &cobra.Command{
+	Use:   "upload alias",
+	Short: "Upload certificate or key pair to the keystore",
+	Long: `You can use the following command to add a certificate (PEM or DER file) or a key pair (PKCS#12 file, --key-pair)
+to the tenant keystore. The password of the key pair is read from stdin (--secret-stdin) or from the secrets file (--secrets-file).
+An existing entry is replaced only with the --overwrite flag.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter alias not set")
+		}
+		alias := args[0]
+		fileName, _ := cmd.Flags().GetString("file")
+		keyPair, _ := cmd.Flags().GetBool("key-pair")
+		overwrite, _ := cmd.Flags().GetBool("overwrite")
+
+		if keyPair {
+			password := readCredentialSecret(cmd, alias, true)
+			client.RunUploadKeystoreKeyPair(os.Stdout, conf, alias, fileName, password, overwrite)
+			return
+		}
+		client.RunUploadKeystoreCertificate(os.Stdout, conf, alias, fileName, overwrite)
+	},
+}
+
+func init() {
+	keystoreCmd.AddCommand(keystoreUploadCmd)
+	keystoreUploadCmd.Flags().StringP("file", "f", "", "Certificate or key pair file")
+	keystoreUploadCmd.MarkFlagRequired("file")
+	keystoreUploadCmd.Flags().Bool("key-pair", false, "The file is a key pair in PKCS#12 format")
+	keystoreUploadCmd.Flags().Bool("overwrite", false, "Replace the existing keystore entry")
+	addCredentialSecretFlags(keystoreUploadCmd)
+}
@@ -0,0 +1,19 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// logsCmd represents the logs command
+var logsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Command related to the system log files",
+}
+
+func init() {
+	rootCmd.AddCommand(logsCmd)
+}
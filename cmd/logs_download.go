@@ -0,0 +1,43 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// logsDownloadCmd represents the download command
+var logsDownloadCmd = &cobra.Command{
+	Use:   "download",
+	Short: "Download system log files to the directory",
+	Long: `You can use the following command to download the log file with the name, or the zip archive
+of all log files of the type (http or trace). With --since only the log files of the type
+modified since then are downloaded, e.g.:
+cig logs download --type http --since 24h --dir logs`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		name, _ := cmd.Flags().GetString("name")
+		logFileType, _ := cmd.Flags().GetString("type")
+		since, _ := cmd.Flags().GetString("since")
+		dir, _ := cmd.Flags().GetString("dir")
+		client.RunDownloadLogFiles(os.Stdout, conf, name, logFileType, since, dir)
+	},
+}
+
+func init() {
+	logsCmd.AddCommand(logsDownloadCmd)
+	logsDownloadCmd.Flags().StringP("name", "n", "", "Name of the log file")
+	logsDownloadCmd.Flags().String("type", "", "Type of the log files. Available values: http, trace")
+	logsDownloadCmd.Flags().String("since", "", "Only log files modified since the duration (e.g. 24h) or the date (e.g. 2022-10-01)")
+	logsDownloadCmd.Flags().StringP("dir", "d", ".", "Target directory")
+}
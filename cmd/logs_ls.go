@@ -0,0 +1,31 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// logsLsCmd represents the ls command
+var logsLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "Get all system log files",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		client.RunGetLogFiles(os.Stdout, conf)
+	},
+}
+
+func init() {
+	logsCmd.AddCommand(logsLsCmd)
+}
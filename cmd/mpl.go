@@ -0,0 +1,19 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// mplCmd represents the mpl command
+var mplCmd = &cobra.Command{
+	Use:   "mpl",
+	Short: "Command related to the message processing logs",
+}
+
+func init() {
+	rootCmd.AddCommand(mplCmd)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// mplTraceCmd represents the trace command
+var mplTraceCmd = &cobra.Command{
+	Use:   "trace message-guid",
+	Short: "Download trace of the message",
+	Long: `You can use the following command to download run steps of the message with payloads, headers
+and exchange properties of the trace messages. The directory contains a folder per run and per step, e.g.:
+run1/001_CallActivity_2/step.json, payload.xml, headers.json, properties.json
+The integration flow has to run with the trace log level, see: cig runtime loglevel`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter message-guid not set")
+		}
+		dir, _ := cmd.Flags().GetString("dir")
+		client.RunDownloadTrace(os.Stdout, conf, args[0], dir)
+	},
+}
+
+func init() {
+	mplCmd.AddCommand(mplTraceCmd)
+	mplTraceCmd.Flags().StringP("dir", "d", "", "Target directory (default message-guid)")
+}
@@ -0,0 +1,20 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// msgStoreCmd represents the msgstore command
+var msgStoreCmd = &cobra.Command{
+	Use:     "msgstore",
+	Aliases: []string{"ms"},
+	Short:   "Command related to the message store entries of the runtime",
+}
+
+func init() {
+	rootCmd.AddCommand(msgStoreCmd)
+}
@@ -0,0 +1,34 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// msgStoreAttachmentsCmd represents the attachments command
+var msgStoreAttachmentsCmd = &cobra.Command{
+	Use:   "attachments entry-id",
+	Short: "Get attachments of the message store entry",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter entry-id not set")
+		}
+		client.RunGetMessageStoreEntryAttachments(os.Stdout, conf, args[0])
+	},
+}
+
+func init() {
+	msgStoreCmd.AddCommand(msgStoreAttachmentsCmd)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// msgStoreDownloadCmd represents the download command
+var msgStoreDownloadCmd = &cobra.Command{
+	Use:   "download entry-id",
+	Short: "Download payload or attachment of the message store entry",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter entry-id not set")
+		}
+		attachmentID, _ := cmd.Flags().GetString("attachment")
+		outputFile, _ := cmd.Flags().GetString("output-file")
+		client.RunDownloadMessageStoreEntry(os.Stdout, conf, args[0], attachmentID, outputFile)
+	},
+}
+
+func init() {
+	msgStoreCmd.AddCommand(msgStoreDownloadCmd)
+	msgStoreDownloadCmd.Flags().StringP("attachment", "a", "", "Attachment id, see attachments command")
+	msgStoreDownloadCmd.Flags().StringP("output-file", "o", "", "Output file name (default entry or attachment id)")
+}
@@ -0,0 +1,34 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// msgStoreLsCmd represents the ls command
+var msgStoreLsCmd = &cobra.Command{
+	Use:   "ls message-guid",
+	Short: "Get message store entries of the message",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter message-guid not set")
+		}
+		client.RunGetMessageStoreEntries(os.Stdout, conf, args[0])
+	},
+}
+
+func init() {
+	msgStoreCmd.AddCommand(msgStoreLsCmd)
+}
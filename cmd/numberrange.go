@@ -0,0 +1,46 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+// numberRangeCmd represents the numberrange command
+var numberRangeCmd = &cobra.Command{
+	Use:     "numberrange",
+	Aliases: []string{"nr"},
+	Short:   "Command related to the number range objects",
+}
+
+func init() {
+	rootCmd.AddCommand(numberRangeCmd)
+}
+
+func addNumberRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().String("description", "", "Description")
+	cmd.Flags().String("min", "", "Min value")
+	cmd.Flags().String("max", "", "Max value")
+	cmd.Flags().String("field-length", "", "Field length")
+	cmd.Flags().Bool("rotate", false, "Restart from the min value when the max value is reached")
+	cmd.Flags().String("current-value", "", "Current value")
+}
+
+func readNumberRangeFlags(cmd *cobra.Command, name string) model.NumberRangeDoc {
+	numberRange := model.NumberRangeDoc{Name: name}
+	numberRange.Description, _ = cmd.Flags().GetString("description")
+	numberRange.MinValue, _ = cmd.Flags().GetString("min")
+	numberRange.MaxValue, _ = cmd.Flags().GetString("max")
+	numberRange.FieldLength, _ = cmd.Flags().GetString("field-length")
+	numberRange.CurrentValue, _ = cmd.Flags().GetString("current-value")
+	if cmd.Flags().Changed("rotate") {
+		numberRange.Rotate = "NO"
+		if rotate, _ := cmd.Flags().GetBool("rotate"); rotate {
+			numberRange.Rotate = "YES"
+		}
+	}
+	return numberRange
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// numberRangeCreateCmd represents the create command
+var numberRangeCreateCmd = &cobra.Command{
+	Use:   "create name",
+	Short: "Create new number range object",
+	Long: `You can use the following command to create new number range object, e.g.:
+cig numberrange create IDOC_NR --min 1 --max 9999999999 --rotate
+The field length defaults to the length of the max value.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter name not set")
+		}
+		client.RunCreateNumberRange(os.Stdout, conf, readNumberRangeFlags(cmd, args[0]))
+	},
+}
+
+func init() {
+	numberRangeCmd.AddCommand(numberRangeCreateCmd)
+	addNumberRangeFlags(numberRangeCreateCmd)
+}
@@ -0,0 +1,34 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// numberRangeDeleteCmd represents the delete command
+var numberRangeDeleteCmd = &cobra.Command{
+	Use:   "delete name",
+	Short: "Delete number range object",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter name not set")
+		}
+		client.RunDeleteNumberRange(os.Stdout, conf, args[0])
+	},
+}
+
+func init() {
+	numberRangeCmd.AddCommand(numberRangeDeleteCmd)
+}
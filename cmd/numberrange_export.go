@@ -0,0 +1,38 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// numberRangeExportCmd represents the export command
+var numberRangeExportCmd = &cobra.Command{
+	Use:   "export [name...]",
+	Short: "Export number range objects to JSON file",
+	Long: `You can use the following command to export definitions of the number range objects (all when no name is given)
+to JSON file. The file can be imported with the import command to another tenant, e.g.:
+cig numberrange export -t dev -o nr.json && cig numberrange import nr.json -t prod`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		outputFile, _ := cmd.Flags().GetString("output-file")
+		withValues, _ := cmd.Flags().GetBool("with-values")
+		client.RunExportNumberRanges(os.Stdout, conf, args, withValues, outputFile)
+	},
+}
+
+func init() {
+	numberRangeCmd.AddCommand(numberRangeExportCmd)
+	numberRangeExportCmd.Flags().StringP("output-file", "o", "", "Output file name (default standard output)")
+	numberRangeExportCmd.Flags().Bool("with-values", false, "Export also current values")
+}
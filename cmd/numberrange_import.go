@@ -0,0 +1,38 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// numberRangeImportCmd represents the import command
+var numberRangeImportCmd = &cobra.Command{
+	Use:   "import file",
+	Short: "Import number range objects from JSON file",
+	Long: `You can use the following command to create missing and update changed number range objects
+from the file created by the export command.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter file not set")
+		}
+		withValues, _ := cmd.Flags().GetBool("with-values")
+		client.RunImportNumberRanges(os.Stdout, conf, args[0], withValues)
+	},
+}
+
+func init() {
+	numberRangeCmd.AddCommand(numberRangeImportCmd)
+	numberRangeImportCmd.Flags().Bool("with-values", false, "Import also current values from the file")
+}
@@ -0,0 +1,31 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// numberRangeLsCmd represents the ls command
+var numberRangeLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "Get all number range objects",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		client.RunGetNumberRanges(os.Stdout, conf)
+	},
+}
+
+func init() {
+	numberRangeCmd.AddCommand(numberRangeLsCmd)
+}
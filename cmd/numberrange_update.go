@@ -0,0 +1,37 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// numberRangeUpdateCmd represents the update command
+var numberRangeUpdateCmd = &cobra.Command{
+	Use:   "update name",
+	Short: "Update number range object",
+	Long: `You can use the following command to update number range object. Only the set flags are changed,
+e.g. to reset the current value: cig numberrange update IDOC_NR --current-value 1`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter name not set")
+		}
+		client.RunUpdateNumberRange(os.Stdout, conf, readNumberRangeFlags(cmd, args[0]))
+	},
+}
+
+func init() {
+	numberRangeCmd.AddCommand(numberRangeUpdateCmd)
+	addNumberRangeFlags(numberRangeUpdateCmd)
+}
@@ -17,7 +17,9 @@ var packageLsCmd = &cobra.Command{
 	Use:   "ls [package-id]",
 	Short: "Get all integration packages as list or get all integration flow of the package",
 	Long: `You can use the following subcommand to get all integration packages of designtime.
-Optionaly you can use this subcommand to get all integration flows of the specified package-id`,
+Optionaly you can use this subcommand to get all integration flows of the specified package-id.
+With the --tag flag only packages with the custom tag values are listed, e.g.:
+cig package ls --tag owner=integration-team --tag criticality=high`,
 	Run: func(cmd *cobra.Command, args []string) {
 		//fmt.Println("packageLs called")
 		conf, err := config.NewConfiguration(TenantKey)
@@ -25,8 +27,11 @@ Optionaly you can use this subcommand to get all integration flows of the specif
 			log.Fatal(err)
 		}
 
+		tags, _ := cmd.Flags().GetStringArray("tag")
 		if len(args) > 0 {
 			client.RunGetFlowsOfIntegrationPackage(conf, args[0])
+		} else if len(tags) > 0 {
+			client.RunGetIntegrationPackagesByTags(conf, tags)
 		} else {
 			client.RunGetIntegrationPackages(conf)
 		}
@@ -46,4 +51,5 @@ func init() {
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// packageLsCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	packageLsCmd.Flags().StringArray("tag", nil, "List only packages with the custom tag key=value, can be repeated")
 }
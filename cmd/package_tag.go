@@ -0,0 +1,37 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// packageTagCmd represents the tag command
+var packageTagCmd = &cobra.Command{
+	Use:   "tag package-id [key=value...]",
+	Short: "Get or set custom tags of integration package",
+	Long: `You can use the following command to set custom tags of an integration package, e.g.:
+cig package tag MyPackage owner=integration-team criticality=high
+Tags which are not given are kept. Without key=value pairs the current tags are printed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter package-id not set")
+		}
+		client.RunTagIntegrationPackage(os.Stdout, conf, args[0], args[1:])
+	},
+}
+
+func init() {
+	packageCmd.AddCommand(packageTagCmd)
+}
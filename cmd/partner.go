@@ -0,0 +1,22 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// partnerCmd represents the partner command
+var partnerCmd = &cobra.Command{
+	Use:     "partner",
+	Aliases: []string{"pd"},
+	Short:   "Command related to the Partner Directory",
+	Long: `Command related to the Partner Directory: partners, string parameters, binary parameters and alternative partners.
+A partner exists as long as it has parameters, so partners are created by adding parameters or by the import command.`,
+}
+
+func init() {
+	rootCmd.AddCommand(partnerCmd)
+}
@@ -0,0 +1,20 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// partnerAlternativeCmd represents the alternative command
+var partnerAlternativeCmd = &cobra.Command{
+	Use:     "alternative",
+	Aliases: []string{"alt"},
+	Short:   "Command related to the alternative partners of the Partner Directory",
+}
+
+func init() {
+	partnerCmd.AddCommand(partnerAlternativeCmd)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerAlternativeCreateCmd represents the create command
+var partnerAlternativeCreateCmd = &cobra.Command{
+	Use:   "create agency scheme id",
+	Short: "Map agency, scheme and id to the partner",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter agency not set")
+		}
+		if len(args) < 2 {
+			log.Fatal("Required parameter scheme not set")
+		}
+		if len(args) < 3 {
+			log.Fatal("Required parameter id not set")
+		}
+		pid, _ := cmd.Flags().GetString("pid")
+		client.RunCreateAlternativePartner(os.Stdout, conf, args[0], args[1], args[2], pid)
+	},
+}
+
+func init() {
+	partnerAlternativeCmd.AddCommand(partnerAlternativeCreateCmd)
+	partnerAlternativeCreateCmd.Flags().String("pid", "", "Partner id")
+	partnerAlternativeCreateCmd.MarkFlagRequired("pid")
+}
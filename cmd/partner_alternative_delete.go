@@ -0,0 +1,40 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerAlternativeDeleteCmd represents the delete command
+var partnerAlternativeDeleteCmd = &cobra.Command{
+	Use:   "delete agency scheme id",
+	Short: "Delete alternative partner",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter agency not set")
+		}
+		if len(args) < 2 {
+			log.Fatal("Required parameter scheme not set")
+		}
+		if len(args) < 3 {
+			log.Fatal("Required parameter id not set")
+		}
+		client.RunDeleteAlternativePartner(os.Stdout, conf, args[0], args[1], args[2])
+	},
+}
+
+func init() {
+	partnerAlternativeCmd.AddCommand(partnerAlternativeDeleteCmd)
+}
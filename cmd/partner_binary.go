@@ -0,0 +1,19 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// partnerBinaryCmd represents the binary command
+var partnerBinaryCmd = &cobra.Command{
+	Use:   "binary",
+	Short: "Command related to the binary parameters of the Partner Directory",
+}
+
+func init() {
+	partnerCmd.AddCommand(partnerBinaryCmd)
+}
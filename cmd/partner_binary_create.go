@@ -0,0 +1,42 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerBinaryCreateCmd represents the create command
+var partnerBinaryCreateCmd = &cobra.Command{
+	Use:   "create pid id",
+	Short: "Add binary parameter to the partner",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter pid not set")
+		}
+		if len(args) < 2 {
+			log.Fatal("Required parameter id not set")
+		}
+		fileName, _ := cmd.Flags().GetString("file")
+		contentType, _ := cmd.Flags().GetString("content-type")
+		client.RunCreateBinaryParameter(os.Stdout, conf, args[0], args[1], contentType, fileName)
+	},
+}
+
+func init() {
+	partnerBinaryCmd.AddCommand(partnerBinaryCreateCmd)
+	partnerBinaryCreateCmd.Flags().StringP("file", "f", "", "File with content of the binary parameter")
+	partnerBinaryCreateCmd.MarkFlagRequired("file")
+	partnerBinaryCreateCmd.Flags().String("content-type", "", "Content type. Available values: xml, xsl, xsd, json, txt, zip, gz, zlib, crt (default inferred from file extension)")
+}
@@ -0,0 +1,37 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerBinaryDeleteCmd represents the delete command
+var partnerBinaryDeleteCmd = &cobra.Command{
+	Use:   "delete pid id",
+	Short: "Delete binary parameter",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter pid not set")
+		}
+		if len(args) < 2 {
+			log.Fatal("Required parameter id not set")
+		}
+		client.RunDeleteBinaryParameter(os.Stdout, conf, args[0], args[1])
+	},
+}
+
+func init() {
+	partnerBinaryCmd.AddCommand(partnerBinaryDeleteCmd)
+}
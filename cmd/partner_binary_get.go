@@ -0,0 +1,39 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerBinaryGetCmd represents the get command
+var partnerBinaryGetCmd = &cobra.Command{
+	Use:   "get pid id",
+	Short: "Download content of the binary parameter",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter pid not set")
+		}
+		if len(args) < 2 {
+			log.Fatal("Required parameter id not set")
+		}
+		outputFile, _ := cmd.Flags().GetString("output-file")
+		client.RunDownloadBinaryParameter(os.Stdout, conf, args[0], args[1], outputFile)
+	},
+}
+
+func init() {
+	partnerBinaryCmd.AddCommand(partnerBinaryGetCmd)
+	partnerBinaryGetCmd.Flags().StringP("output-file", "o", "", "Output file name (default standard output)")
+}
@@ -0,0 +1,35 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerBinaryLsCmd represents the ls command
+var partnerBinaryLsCmd = &cobra.Command{
+	Use:   "ls [pid]",
+	Short: "Get binary parameters of the partner or all binary parameters",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		pid := ""
+		if len(args) > 0 {
+			pid = args[0]
+		}
+		client.RunGetBinaryParameters(os.Stdout, conf, pid)
+	},
+}
+
+func init() {
+	partnerBinaryCmd.AddCommand(partnerBinaryLsCmd)
+}
@@ -0,0 +1,34 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerDeleteCmd represents the delete command
+var partnerDeleteCmd = &cobra.Command{
+	Use:   "delete pid",
+	Short: "Delete partner with all its parameters",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter pid not set")
+		}
+		client.RunDeletePartner(os.Stdout, conf, args[0])
+	},
+}
+
+func init() {
+	partnerCmd.AddCommand(partnerDeleteCmd)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerExportCmd represents the export command
+var partnerExportCmd = &cobra.Command{
+	Use:   "export pid",
+	Short: "Export all parameters of the partner to JSON or YAML file",
+	Long: `You can use the following command to export string parameters, binary parameters and alternative partners
+of the partner to JSON or YAML file. The file can be imported with the import command, e.g. to another tenant.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter pid not set")
+		}
+		outputFile, _ := cmd.Flags().GetString("output-file")
+		format, _ := cmd.Flags().GetString("format")
+		client.RunExportPartner(os.Stdout, conf, args[0], outputFile, format)
+	},
+}
+
+func init() {
+	partnerCmd.AddCommand(partnerExportCmd)
+	partnerExportCmd.Flags().StringP("output-file", "o", "", "Output file name (default standard output)")
+	partnerExportCmd.Flags().String("format", "", "File format. Available values: json, yaml (default inferred from file extension, json)")
+}
@@ -0,0 +1,53 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerImportCmd represents the import command
+var partnerImportCmd = &cobra.Command{
+	Use:   "import file",
+	Short: "Import parameters of the partner from JSON or YAML file",
+	Long: `You can use the following command to onboard a partner in one step. Missing string parameters, binary parameters
+and alternative partners from the file are created and changed ones are updated. Example of YAML file:
+
+pid: SenderA
+stringParameters:
+  - id: ReceiverURL
+    value: https://example.com/orders
+binaryParameters:
+  - id: Mapping
+    contentType: xsl
+    value: PHhzbDpzdHlsZXNoZWV0Lz4=
+alternativePartners:
+  - agency: SAP
+    scheme: SenderSystem
+    id: ERP_100`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter file not set")
+		}
+		format, _ := cmd.Flags().GetString("format")
+		pid, _ := cmd.Flags().GetString("pid")
+		client.RunImportPartner(os.Stdout, conf, args[0], format, pid)
+	},
+}
+
+func init() {
+	partnerCmd.AddCommand(partnerImportCmd)
+	partnerImportCmd.Flags().String("format", "", "File format. Available values: json, yaml (default inferred from file extension, json)")
+	partnerImportCmd.Flags().String("pid", "", "Partner id (default pid from the file)")
+}
@@ -0,0 +1,31 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerLsCmd represents the ls command
+var partnerLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "Get all partners of the Partner Directory",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		client.RunGetPartners(os.Stdout, conf)
+	},
+}
+
+func init() {
+	partnerCmd.AddCommand(partnerLsCmd)
+}
@@ -0,0 +1,19 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// partnerStringCmd represents the string command
+var partnerStringCmd = &cobra.Command{
+	Use:   "string",
+	Short: "Command related to the string parameters of the Partner Directory",
+}
+
+func init() {
+	partnerCmd.AddCommand(partnerStringCmd)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerStringCreateCmd represents the create command
+var partnerStringCreateCmd = &cobra.Command{
+	Use:   "create pid id",
+	Short: "Add string parameter to the partner",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter pid not set")
+		}
+		if len(args) < 2 {
+			log.Fatal("Required parameter id not set")
+		}
+		value, _ := cmd.Flags().GetString("value")
+		client.RunCreateStringParameter(os.Stdout, conf, args[0], args[1], value)
+	},
+}
+
+func init() {
+	partnerStringCmd.AddCommand(partnerStringCreateCmd)
+	partnerStringCreateCmd.Flags().String("value", "", "Value of the string parameter")
+	partnerStringCreateCmd.MarkFlagRequired("value")
+}
@@ -0,0 +1,37 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerStringGetCmd represents the get command
+var partnerStringGetCmd = &cobra.Command{
+	Use:   "get pid id",
+	Short: "Get string parameter by partner id and id",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter pid not set")
+		}
+		if len(args) < 2 {
+			log.Fatal("Required parameter id not set")
+		}
+		client.RunGetStringParameter(os.Stdout, conf, args[0], args[1])
+	},
+}
+
+func init() {
+	partnerStringCmd.AddCommand(partnerStringGetCmd)
+}
@@ -0,0 +1,35 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerStringLsCmd represents the ls command
+var partnerStringLsCmd = &cobra.Command{
+	Use:   "ls [pid]",
+	Short: "Get string parameters of the partner or all string parameters",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		pid := ""
+		if len(args) > 0 {
+			pid = args[0]
+		}
+		client.RunGetStringParameters(os.Stdout, conf, pid)
+	},
+}
+
+func init() {
+	partnerStringCmd.AddCommand(partnerStringLsCmd)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// partnerStringUpdateCmd represents the update command
+var partnerStringUpdateCmd = &cobra.Command{
+	Use:   "update pid id",
+	Short: "Change value of the string parameter",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter pid not set")
+		}
+		if len(args) < 2 {
+			log.Fatal("Required parameter id not set")
+		}
+		value, _ := cmd.Flags().GetString("value")
+		client.RunUpdateStringParameter(os.Stdout, conf, args[0], args[1], value)
+	},
+}
+
+func init() {
+	partnerStringCmd.AddCommand(partnerStringUpdateCmd)
+	partnerStringUpdateCmd.Flags().String("value", "", "Value of the string parameter")
+	partnerStringUpdateCmd.MarkFlagRequired("value")
+}
@@ -0,0 +1,52 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// planCmd represents the plan command
+var planCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Show the changes needed to bring the tenant to the state of the state file",
+	Long: `You can use the following command to compare the tenant with the state file and list the changes
+which would be executed by apply. The state file defines per tenant key the credentials,
+the integration packages and their integration flows with the source (directory created by flow pull
+or zip file), the configuration values and the deploy state. The passwords of the credentials are read from the environment variables, e.g.:
+tenants:
+  dev:
+    credentials:
+      - name: SFTP_User
+        user: sftpuser
+        passwordEnv: SFTP_PASSWORD
+    packages:
+      - id: POscenerio
+        name: Purchase Orders
+        flows:
+          - id: PurchaseOrder
+            source: flows/PurchaseOrder
+            configurations:
+              SFTP_Host: sftp.example.com
+            deploy: true`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fileName, _ := cmd.Flags().GetString("file")
+		client.RunPlanState(os.Stdout, conf, fileName)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(planCmd)
+	planCmd.Flags().StringP("file", "f", "cig.yaml", "State file in yaml or json format")
+}
@@ -0,0 +1,48 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// resourceCreateCmd represents the resourceCreate command
+var resourceCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Add a resource to an integration flow",
+	Long:  `You can use the following command to add a new resource (script, xsd, wsdl...) to an integration flow from designtime.`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		flowId, _ := cmd.Flags().GetString("flow-id")
+		flowVersion, _ := cmd.Flags().GetString("flow-version")
+		resourceName, _ := cmd.Flags().GetString("resource-name")
+		resourceType, _ := cmd.Flags().GetString("resource-type")
+		resourceFileName, _ := cmd.Flags().GetString("resource-file-name")
+
+		client.RunResourceCreate(os.Stdout, conf, flowId, flowVersion, resourceName, resourceType, resourceFileName)
+	},
+}
+
+func init() {
+	resourceCmd.AddCommand(resourceCreateCmd)
+
+	resourceCreateCmd.Flags().StringP("flow-id", "i", "", "Integration Flow id")
+	resourceCreateCmd.MarkFlagRequired("flow-id")
+	resourceCreateCmd.Flags().StringP("flow-version", "v", "active", "Integration Flow version")
+	resourceCreateCmd.Flags().StringP("resource-name", "n", "", "Resource name (default Resource file name)")
+	resourceCreateCmd.Flags().StringP("resource-type", "y", "", "Resource type. Available values: edmx, groovy, jar, js, mmap, opmap, wsdl, xsd, xslt (default inferred from file extension)")
+	resourceCreateCmd.Flags().StringP("resource-file-name", "f", "", "Resource file (.groovy,.js,.wsdl...)")
+	resourceCreateCmd.MarkFlagRequired("resource-file-name")
+}
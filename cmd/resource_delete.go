@@ -0,0 +1,46 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// resourceDeleteCmd represents the resourceDelete command
+var resourceDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a resource of an integration flow",
+	Long:  `You can use the following command to delete a resource of an integration flow from designtime.`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		flowId, _ := cmd.Flags().GetString("flow-id")
+		flowVersion, _ := cmd.Flags().GetString("flow-version")
+		resourceName, _ := cmd.Flags().GetString("resource-name")
+		resourceType, _ := cmd.Flags().GetString("resource-type")
+
+		client.RunResourceDelete(os.Stdout, conf, flowId, flowVersion, resourceName, resourceType)
+	},
+}
+
+func init() {
+	resourceCmd.AddCommand(resourceDeleteCmd)
+
+	resourceDeleteCmd.Flags().StringP("flow-id", "i", "", "Integration Flow id")
+	resourceDeleteCmd.MarkFlagRequired("flow-id")
+	resourceDeleteCmd.Flags().StringP("flow-version", "v", "active", "Integration Flow version")
+	resourceDeleteCmd.Flags().StringP("resource-name", "n", "", "Resource name")
+	resourceDeleteCmd.MarkFlagRequired("resource-name")
+	resourceDeleteCmd.Flags().StringP("resource-type", "y", "", "Resource type. Available values: edmx, groovy, jar, js, mmap, opmap, wsdl, xsd, xslt (default inferred from resource name)")
+}
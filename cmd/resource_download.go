@@ -0,0 +1,45 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// resourceDownloadCmd represents the resourceDownload command
+var resourceDownloadCmd = &cobra.Command{
+	Use:   "download flow-id",
+	Short: "Download resources of an integration flow",
+	Long: `You can use the following subcommand to download one resource or all resources
+of an integration flow from designtime into a directory.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter flow-id not set")
+		}
+		flowVersion, _ := cmd.Flags().GetString("flow-version")
+		resourceName, _ := cmd.Flags().GetString("resource-name")
+		resourceType, _ := cmd.Flags().GetString("resource-type")
+		targetDir, _ := cmd.Flags().GetString("target-dir")
+
+		client.RunDownloadResources(os.Stdout, conf, args[0], flowVersion, resourceName, resourceType, targetDir)
+	},
+}
+
+func init() {
+	resourceCmd.AddCommand(resourceDownloadCmd)
+	resourceDownloadCmd.Flags().StringP("flow-version", "v", "active", "Integration Flow version")
+	resourceDownloadCmd.Flags().StringP("resource-name", "n", "", "Resource name (default all resources)")
+	resourceDownloadCmd.Flags().StringP("resource-type", "y", "", "Resource type. Available values: edmx, groovy, jar, js, mmap, opmap, wsdl, xsd, xslt (default inferred from resource name)")
+	resourceDownloadCmd.Flags().StringP("target-dir", "d", ".", "Target directory")
+}
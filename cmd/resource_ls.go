@@ -0,0 +1,37 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// resourceLsCmd represents the resourceLs command
+var resourceLsCmd = &cobra.Command{
+	Use:   "ls flow-id",
+	Short: "Get all resources of an integration flow",
+	Long:  `You can use the following subcommand to get name, type and size of all resources of an integration flow from designtime.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter flow-id not set")
+		}
+		flowVersion, _ := cmd.Flags().GetString("flow-version")
+		client.RunGetResources(os.Stdout, conf, args[0], flowVersion)
+	},
+}
+
+func init() {
+	resourceCmd.AddCommand(resourceLsCmd)
+	resourceLsCmd.Flags().StringP("flow-version", "v", "active", "Integration Flow version")
+}
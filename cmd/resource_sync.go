@@ -0,0 +1,48 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// resourceSyncCmd represents the resourceSync command
+var resourceSyncCmd = &cobra.Command{
+	Use:   "sync flow-id directory",
+	Short: "Synchronize resources of an integration flow with a local directory",
+	Long: `You can use the following command to synchronize resources of an integration flow from designtime
+with files of a local directory. Files are compared with resources by name and content hash,
+missing resources are created and changed resources are updated. Resources without local file are deleted
+only with --delete flag. The plan is printed first, use --dry-run to only print the plan.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter flow-id not set")
+		}
+		if len(args) == 1 {
+			log.Fatal("Required parameter directory not set")
+		}
+		flowVersion, _ := cmd.Flags().GetString("flow-version")
+		deleteOrphans, _ := cmd.Flags().GetBool("delete")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		client.RunSyncResources(os.Stdout, conf, args[0], flowVersion, args[1], deleteOrphans, dryRun)
+	},
+}
+
+func init() {
+	resourceCmd.AddCommand(resourceSyncCmd)
+	resourceSyncCmd.Flags().StringP("flow-version", "v", "active", "Integration Flow version")
+	resourceSyncCmd.Flags().Bool("delete", false, "Delete resources which do not exist in the directory")
+	resourceSyncCmd.Flags().Bool("dry-run", false, "Print the plan without applying changes")
+}
@@ -42,7 +42,7 @@ func init() {
 	resourceUpdateCmd.MarkFlagRequired("flow-id")
 	resourceUpdateCmd.Flags().StringP("flow-version", "v", "active", "Integration Flow version")
 	resourceUpdateCmd.Flags().StringP("resource-name", "n", "", "Resource name (default Resource file name)")
-	resourceUpdateCmd.Flags().StringP("resource-type", "y", "groovy", "Resource type. Available values: edmx, groovy, jar, js, mmap, opmap, wsdl, xsd, xslt")
+	resourceUpdateCmd.Flags().StringP("resource-type", "y", "", "Resource type. Available values: edmx, groovy, jar, js, mmap, opmap, wsdl, xsd, xslt (default inferred from file extension)")
 	resourceUpdateCmd.Flags().StringP("resource-file-name", "f", "", "Resource file (.groovy,.js,.wsdl...)")
 	resourceUpdateCmd.MarkFlagRequired("resource-file-name")
 	// Here you will define your flags and configuration settings.
@@ -0,0 +1,19 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// runtimeCmd represents the runtime command
+var runtimeCmd = &cobra.Command{
+	Use:   "runtime",
+	Short: "Command related to the deployed artifacts of the runtime",
+}
+
+func init() {
+	rootCmd.AddCommand(runtimeCmd)
+}
@@ -0,0 +1,39 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// runtimeLogLevelCmd represents the loglevel command
+var runtimeLogLevelCmd = &cobra.Command{
+	Use:   "loglevel flow-id",
+	Short: "Get or set log level of deployed integration flow",
+	Long: `You can use the following command to set the log level of the deployed integration flow, e.g.:
+cig runtime loglevel MyFlow --level trace
+The trace level is reset by the tenant to info after 10 minutes.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter flow-id not set")
+		}
+		level, _ := cmd.Flags().GetString("level")
+		client.RunRuntimeLogLevel(os.Stdout, conf, args[0], level)
+	},
+}
+
+func init() {
+	runtimeCmd.AddCommand(runtimeLogLevelCmd)
+	runtimeLogLevelCmd.Flags().StringP("level", "l", "", "Log level. Available values: info, debug, trace (default print current log level)")
+}
@@ -0,0 +1,49 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// scriptTestCmd represents the script test command
+var scriptTestCmd = &cobra.Command{
+	Use:   "test flow-id|zip|dir fixtures-dir",
+	Short: "Run the Groovy scripts of an integration flow locally against fixtures",
+	Long: `You can use the following command to run the Groovy scripts of the integration flow locally with groovy
+and a stub of the com.sap.gateway.ip.core.customdev.util.Message API. Each json file of the fixtures directory
+calls the function of the script with the input message and compares the result to the expected message,
+only the body, headers and properties set in expected are compared, e.g.:
+{
+  "script": "script/AddHeader.groovy",
+  "function": "processData",
+  "input": {"bodyFile": "order.xml", "headers": {"SAP_Sender": "ERP"}, "properties": {"mode": "test"}},
+  "expected": {"body": "<order/>", "headers": {"OrderType": "standard"}}
+}
+The flow is read from the directory created by flow pull, from the zip file or downloaded from the tenant.
+The command exits with non-zero status when any of the fixtures failed, e.g.:
+cig script test flows/PurchaseOrder flows/PurchaseOrder-tests`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 2 {
+			log.Fatal("Required parameters flow-id|zip|dir and fixtures-dir not set")
+		}
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil && !client.IsLocalFlowSource(args[0]) {
+			log.Fatal(err)
+		}
+		groovy, _ := cmd.Flags().GetString("groovy")
+		client.RunTestScripts(os.Stdout, conf, args[0], args[1], groovy)
+	},
+}
+
+func init() {
+	scriptCmd.AddCommand(scriptTestCmd)
+	scriptTestCmd.Flags().StringP("groovy", "g", "groovy", "The groovy command or the path of groovy")
+}
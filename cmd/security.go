@@ -0,0 +1,19 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// securityCmd represents the security command
+var securityCmd = &cobra.Command{
+	Use:   "security",
+	Short: "Command related to the security material of the tenant",
+}
+
+func init() {
+	rootCmd.AddCommand(securityCmd)
+}
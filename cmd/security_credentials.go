@@ -0,0 +1,84 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+)
+
+// securityCredentialsCmd represents the security credentials command
+var securityCredentialsCmd = &cobra.Command{
+	Use:     "credentials",
+	Aliases: []string{"cred"},
+	Short:   "Command related to the user credentials and OAuth2 client credentials",
+	Long: `Command related to the user credentials and OAuth2 client credentials.
+The credential type is selected with the --type flag: user, oauth2.
+Secret values (password, client secret) are read from stdin (--secret-stdin) or from
+a secrets file with lines name=secret (--secrets-file) and are never printed.`,
+}
+
+func init() {
+	securityCmd.AddCommand(securityCredentialsCmd)
+	securityCredentialsCmd.PersistentFlags().StringP("type", "y", "", "Credential type. Available values: user, oauth2 (default all types, or looked up by name)")
+}
+
+func addCredentialSecretFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("secret-stdin", false, "Read the secret value from stdin")
+	cmd.Flags().String("secrets-file", "", "Read the secret value from file with lines name=secret")
+}
+
+//readCredentialSecret returns empty string when no secret source is given and the secret is not required
+func readCredentialSecret(cmd *cobra.Command, name string, required bool) string {
+	secretStdin, _ := cmd.Flags().GetBool("secret-stdin")
+	secretsFile, _ := cmd.Flags().GetString("secrets-file")
+	if !secretStdin && secretsFile == "" {
+		if required {
+			log.Fatal("Secret not set, use --secret-stdin or --secrets-file")
+		}
+		return ""
+	}
+	if secretStdin && secretsFile != "" {
+		log.Fatal("Flags --secret-stdin and --secrets-file cannot be used together")
+	}
+
+	secret, err := client.ReadSecret(os.Stdin, secretsFile, name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return secret
+}
+
+func getCredentialTypeFlag(cmd *cobra.Command, defaultType string) string {
+	credentialType, _ := cmd.Flags().GetString("type")
+	if credentialType == "" {
+		credentialType = defaultType
+	}
+	switch credentialType {
+	case "", client.CredentialTypeUser, client.CredentialTypeOAuth2:
+		return credentialType
+	}
+	log.Fatal(fmt.Errorf("%w: unknown credential type %s", client.ErrInvalid, credentialType))
+	return ""
+}
+
+func addCredentialFlags(cmd *cobra.Command) {
+	cmd.Flags().String("description", "", "Description")
+	cmd.Flags().String("kind", "", "User credential kind. Available values: default, successfactors, openconnectors")
+	cmd.Flags().String("user", "", "User name of the user credential")
+	cmd.Flags().String("company-id", "", "Company id of the SuccessFactors user credential")
+	cmd.Flags().String("token-url", "", "Token service URL of the OAuth2 client credential")
+	cmd.Flags().String("client-id", "", "Client id of the OAuth2 client credential")
+	cmd.Flags().String("client-authentication", "", "Client authentication of the OAuth2 client credential (default \"Send as Request Header\")")
+	cmd.Flags().String("scope", "", "Scope of the OAuth2 client credential")
+	cmd.Flags().String("scope-content-type", "", "Scope content type of the OAuth2 client credential (default \"application/x-www-form-urlencoded\")")
+	cmd.Flags().String("resource", "", "Resource of the OAuth2 client credential")
+	cmd.Flags().String("audience", "", "Audience of the OAuth2 client credential")
+	addCredentialSecretFlags(cmd)
+}
@@ -0,0 +1,35 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// securityCredentialsDeleteCmd represents the security credentials delete command
+var securityCredentialsDeleteCmd = &cobra.Command{
+	Use:   "delete name",
+	Short: "Delete credential by name",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter name not set")
+		}
+		credentialType := getCredentialTypeFlag(cmd, "")
+		client.RunDeleteCredential(os.Stdout, conf, credentialType, args[0])
+	},
+}
+
+func init() {
+	securityCredentialsCmd.AddCommand(securityCredentialsDeleteCmd)
+}
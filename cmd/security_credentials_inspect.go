@@ -0,0 +1,36 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// securityCredentialsInspectCmd represents the security credentials inspect command
+var securityCredentialsInspectCmd = &cobra.Command{
+	Use:   "inspect name",
+	Short: "Get credential by name",
+	Long:  `You can use the following command to get user credential or OAuth2 client credential by name. Secret values are not returned.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter name not set")
+		}
+		credentialType := getCredentialTypeFlag(cmd, "")
+		client.RunInspectCredential(os.Stdout, conf, credentialType, args[0])
+	},
+}
+
+func init() {
+	securityCredentialsCmd.AddCommand(securityCredentialsInspectCmd)
+}
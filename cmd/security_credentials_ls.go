@@ -0,0 +1,32 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// securityCredentialsLsCmd represents the security credentials ls command
+var securityCredentialsLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "Get all user credentials and OAuth2 client credentials",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		credentialType := getCredentialTypeFlag(cmd, "")
+		client.RunGetCredentials(os.Stdout, conf, credentialType)
+	},
+}
+
+func init() {
+	securityCredentialsCmd.AddCommand(securityCredentialsLsCmd)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+	"github.com/tobiaszgithub/cig/model"
+)
+
+// securityCredentialsUpdateCmd represents the security credentials update command
+var securityCredentialsUpdateCmd = &cobra.Command{
+	Use:   "update name",
+	Short: "Update deployed credential",
+	Long: `You can use the following command to update a deployed user credential (--type user) or OAuth2 client credential (--type oauth2).
+The password or client secret is read from stdin (--secret-stdin) or from the secrets file (--secrets-file).`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter name not set")
+		}
+		name := args[0]
+		credentialType := getCredentialTypeFlag(cmd, "")
+		if credentialType == "" {
+			credentialType, err = client.FindCredentialType(conf, name)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+		description, _ := cmd.Flags().GetString("description")
+		secret := readCredentialSecret(cmd, name, false)
+
+		if credentialType == client.CredentialTypeOAuth2 {
+			credential := model.OAuth2ClientCredential{Name: name, Description: description, ClientSecret: secret}
+			credential.TokenServiceUrl, _ = cmd.Flags().GetString("token-url")
+			credential.ClientId, _ = cmd.Flags().GetString("client-id")
+			credential.ClientAuthentication, _ = cmd.Flags().GetString("client-authentication")
+			credential.Scope, _ = cmd.Flags().GetString("scope")
+			credential.ScopeContentType, _ = cmd.Flags().GetString("scope-content-type")
+			credential.Resource, _ = cmd.Flags().GetString("resource")
+			credential.Audience, _ = cmd.Flags().GetString("audience")
+			client.RunUpdateOAuth2ClientCredential(os.Stdout, conf, credential)
+			return
+		}
+
+		credential := model.UserCredential{Name: name, Description: description, Password: secret}
+		credential.Kind, _ = cmd.Flags().GetString("kind")
+		credential.User, _ = cmd.Flags().GetString("user")
+		credential.CompanyId, _ = cmd.Flags().GetString("company-id")
+		client.RunUpdateUserCredential(os.Stdout, conf, credential)
+	},
+}
+
+func init() {
+	securityCredentialsCmd.AddCommand(securityCredentialsUpdateCmd)
+	addCredentialFlags(securityCredentialsUpdateCmd)
+}
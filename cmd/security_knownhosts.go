@@ -0,0 +1,20 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// securityKnownHostsCmd represents the security knownhosts command
+var securityKnownHostsCmd = &cobra.Command{
+	Use:     "knownhosts",
+	Aliases: []string{"known_hosts"},
+	Short:   "Command related to the SSH known_hosts file of the tenant",
+}
+
+func init() {
+	securityCmd.AddCommand(securityKnownHostsCmd)
+}
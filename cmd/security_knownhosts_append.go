@@ -0,0 +1,35 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// securityKnownHostsAppendCmd represents the security knownhosts append command
+var securityKnownHostsAppendCmd = &cobra.Command{
+	Use:   "append",
+	Short: "Append entries to the known_hosts file",
+	Long: `You can use the following command to append entries (e.g. output of ssh-keyscan) to the known_hosts file of the tenant.
+Entries are read from the file (--file) or from stdin, entries which already exist are skipped.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fileName, _ := cmd.Flags().GetString("file")
+		client.RunAppendKnownHosts(os.Stdout, conf, fileName)
+	},
+}
+
+func init() {
+	securityKnownHostsCmd.AddCommand(securityKnownHostsAppendCmd)
+	securityKnownHostsAppendCmd.Flags().StringP("file", "f", "", "File with known_hosts entries (default standard input)")
+}
@@ -0,0 +1,33 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// securityKnownHostsDownloadCmd represents the security knownhosts download command
+var securityKnownHostsDownloadCmd = &cobra.Command{
+	Use:   "download",
+	Short: "Download the known_hosts file",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		outputFile, _ := cmd.Flags().GetString("output-file")
+		client.RunDownloadKnownHosts(os.Stdout, conf, outputFile)
+	},
+}
+
+func init() {
+	securityKnownHostsCmd.AddCommand(securityKnownHostsDownloadCmd)
+	securityKnownHostsDownloadCmd.Flags().StringP("output-file", "o", "", "Output file name (default standard output)")
+}
@@ -0,0 +1,34 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// securityKnownHostsUploadCmd represents the security knownhosts upload command
+var securityKnownHostsUploadCmd = &cobra.Command{
+	Use:   "upload",
+	Short: "Replace the known_hosts file",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fileName, _ := cmd.Flags().GetString("file")
+		client.RunUploadKnownHosts(os.Stdout, conf, fileName)
+	},
+}
+
+func init() {
+	securityKnownHostsCmd.AddCommand(securityKnownHostsUploadCmd)
+	securityKnownHostsUploadCmd.Flags().StringP("file", "f", "", "known_hosts file")
+	securityKnownHostsUploadCmd.MarkFlagRequired("file")
+}
@@ -0,0 +1,23 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// securityParametersCmd represents the security parameters command
+var securityParametersCmd = &cobra.Command{
+	Use:     "parameters",
+	Aliases: []string{"param"},
+	Short:   "Command related to the secure parameters",
+	Long: `Command related to the secure parameters.
+The value of the secure parameter is read from stdin (--secret-stdin) or from
+a secrets file with lines name=value (--secrets-file) and is never printed.`,
+}
+
+func init() {
+	securityCmd.AddCommand(securityParametersCmd)
+}
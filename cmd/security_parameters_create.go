@@ -0,0 +1,39 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// securityParametersCreateCmd represents the security parameters create command
+var securityParametersCreateCmd = &cobra.Command{
+	Use:   "create name",
+	Short: "Deploy new secure parameter",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter name not set")
+		}
+		name := args[0]
+		description, _ := cmd.Flags().GetString("description")
+		value := readCredentialSecret(cmd, name, true)
+		client.RunCreateSecureParameter(os.Stdout, conf, name, description, value)
+	},
+}
+
+func init() {
+	securityParametersCmd.AddCommand(securityParametersCreateCmd)
+	securityParametersCreateCmd.Flags().String("description", "", "Description")
+	addCredentialSecretFlags(securityParametersCreateCmd)
+}
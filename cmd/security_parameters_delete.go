@@ -0,0 +1,34 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// securityParametersDeleteCmd represents the security parameters delete command
+var securityParametersDeleteCmd = &cobra.Command{
+	Use:   "delete name",
+	Short: "Delete secure parameter by name",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter name not set")
+		}
+		client.RunDeleteSecureParameter(os.Stdout, conf, args[0])
+	},
+}
+
+func init() {
+	securityParametersCmd.AddCommand(securityParametersDeleteCmd)
+}
@@ -0,0 +1,21 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// valueMappingCmd represents the valuemapping command
+var valueMappingCmd = &cobra.Command{
+	Use:     "valuemapping",
+	Aliases: []string{"vm"},
+	Short:   "Command related to the processing of a value mapping",
+	Long:    `Command related to the processing of a value mapping.`,
+}
+
+func init() {
+	rootCmd.AddCommand(valueMappingCmd)
+}
@@ -0,0 +1,44 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// valueMappingCopyCmd represents the valueMappingCopy command
+var valueMappingCopyCmd = &cobra.Command{
+	Use:   "copy [source-valuemapping-id] [destination-valuemapping-id]",
+	Short: "Copy a value mapping",
+	Long: `You can use the following subcommand to copy
+a value mapping of designtime. `,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter source-valuemapping-id not set")
+		}
+		if len(args) == 1 {
+			log.Fatal("Required parameter destination-valuemapping-id not set")
+		}
+		destName, _ := cmd.Flags().GetString("dest-name")
+		destPackageID, _ := cmd.Flags().GetString("dest-package-id")
+
+		client.RunCopyValueMapping(os.Stdout, conf, args[0], args[1], destName, destPackageID)
+	},
+}
+
+func init() {
+	valueMappingCmd.AddCommand(valueMappingCopyCmd)
+	valueMappingCopyCmd.Flags().StringP("dest-name", "n", "", "Destination value mapping name")
+	valueMappingCopyCmd.Flags().StringP("dest-package-id", "p", "", "Destination value mapping package id")
+}
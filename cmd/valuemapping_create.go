@@ -0,0 +1,44 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// valueMappingCreateCmd represents the valueMappingCreate command
+var valueMappingCreateCmd = &cobra.Command{
+	Use:     "create",
+	Aliases: []string{"upload"},
+	Short:   "Create or upload a value mapping",
+	Long: `You can use the following subcommand to create or upload
+a value mapping of designtime`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		id, _ := cmd.Flags().GetString("id")
+		packageid, _ := cmd.Flags().GetString("package-id")
+		fileName, _ := cmd.Flags().GetString("content-file-name")
+
+		client.RunCreateValueMapping(os.Stdout, conf, name, id, packageid, fileName)
+	},
+}
+
+func init() {
+	valueMappingCmd.AddCommand(valueMappingCreateCmd)
+	valueMappingCreateCmd.Flags().StringP("name", "n", "", "Value mapping name")
+	valueMappingCreateCmd.Flags().StringP("id", "i", "", "Value mapping id")
+	valueMappingCreateCmd.Flags().StringP("package-id", "p", "", "Value mapping package id")
+	valueMappingCreateCmd.Flags().StringP("content-file-name", "f", "", "Value mapping artifact content file (.zip)")
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// valueMappingDeployCmd represents the valueMappingDeploy command
+var valueMappingDeployCmd = &cobra.Command{
+	Use:   "deploy valuemapping-id",
+	Short: "Deploy a value mapping",
+	Long:  `You can use the following request to deploy a value mapping of designtime.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			log.Fatal("Required parameter valuemapping-id not set")
+		}
+		version, _ := cmd.Flags().GetString("version")
+		client.RunDeployValueMapping(os.Stdout, conf, args[0], version)
+	},
+}
+
+func init() {
+	valueMappingCmd.AddCommand(valueMappingDeployCmd)
+	valueMappingDeployCmd.Flags().StringP("version", "v", "active", "Value mapping version")
+}
@@ -0,0 +1,39 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// valueMappingDownloadCmd represents the valueMappingDownload command
+var valueMappingDownloadCmd = &cobra.Command{
+	Use:   "download valuemapping-id",
+	Short: "Download a value mapping as zip file",
+	Long:  `You can use the following subcommand to download a value mapping of designtime as zip file.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter valuemapping-id not set")
+		}
+		fileName, _ := cmd.Flags().GetString("output-file")
+		version, _ := cmd.Flags().GetString("version")
+		client.RunDownloadValueMapping(os.Stdout, conf, args[0], version, fileName)
+	},
+}
+
+func init() {
+	valueMappingCmd.AddCommand(valueMappingDownloadCmd)
+	valueMappingDownloadCmd.Flags().StringP("output-file", "o", "", "The output file with value mapping [default value valueMappingId.zip]")
+	valueMappingDownloadCmd.Flags().StringP("version", "v", "active", "Value mapping version")
+}
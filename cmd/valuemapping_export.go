@@ -0,0 +1,43 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// valueMappingExportCmd represents the valueMappingExport command
+var valueMappingExportCmd = &cobra.Command{
+	Use:   "export valuemapping-id --csv",
+	Short: "Export entries of a value mapping as CSV",
+	Long: `You can use the following subcommand to export entries of a value mapping of designtime
+as CSV. Each line contains group id, source agency, schema and value and target agency, schema and value.
+The entries are written to standard output or to the file given with --output-file.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter valuemapping-id not set")
+		}
+		csvFileName, _ := cmd.Flags().GetString("output-file")
+		version, _ := cmd.Flags().GetString("version")
+		client.RunExportValueMapping(os.Stdout, conf, args[0], version, csvFileName)
+	},
+}
+
+func init() {
+	valueMappingCmd.AddCommand(valueMappingExportCmd)
+	valueMappingExportCmd.Flags().BoolP("csv", "c", false, "Export the entries in CSV format")
+	valueMappingExportCmd.MarkFlagRequired("csv")
+	valueMappingExportCmd.Flags().StringP("output-file", "o", "", "The output CSV file that will be created [default stdout]")
+	valueMappingExportCmd.Flags().StringP("version", "v", "active", "Value mapping version")
+}
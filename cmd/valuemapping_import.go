@@ -0,0 +1,44 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// valueMappingImportCmd represents the valueMappingImport command
+var valueMappingImportCmd = &cobra.Command{
+	Use:   "import valuemapping-id --csv",
+	Short: "Import entries of a value mapping from CSV",
+	Long: `You can use the following subcommand to replace entries of a value mapping of designtime
+with entries from the CSV file given with --input-file. CSV file has format like output from export.
+Added, removed and changed entries are reported.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter valuemapping-id not set")
+		}
+		csvFileName, _ := cmd.Flags().GetString("input-file")
+		version, _ := cmd.Flags().GetString("version")
+		client.RunImportValueMapping(os.Stdout, conf, args[0], version, csvFileName)
+	},
+}
+
+func init() {
+	valueMappingCmd.AddCommand(valueMappingImportCmd)
+	valueMappingImportCmd.Flags().BoolP("csv", "c", false, "Import the entries in CSV format")
+	valueMappingImportCmd.MarkFlagRequired("csv")
+	valueMappingImportCmd.Flags().StringP("input-file", "f", "", "The input CSV file with value mapping entries")
+	valueMappingImportCmd.MarkFlagRequired("input-file")
+	valueMappingImportCmd.Flags().StringP("version", "v", "active", "Value mapping version")
+}
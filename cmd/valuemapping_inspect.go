@@ -0,0 +1,37 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// valueMappingInspectCmd represents the valueMappingInspect command
+var valueMappingInspectCmd = &cobra.Command{
+	Use:   "inspect valuemapping-id",
+	Short: "Get value mapping by id and version",
+	Long:  `You can use the following subcommand to get a value mapping of designtime by Id and version.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter valuemapping-id not set")
+		}
+		version, _ := cmd.Flags().GetString("version")
+		client.RunInspectValueMapping(os.Stdout, conf, args[0], version)
+	},
+}
+
+func init() {
+	valueMappingCmd.AddCommand(valueMappingInspectCmd)
+	valueMappingInspectCmd.Flags().StringP("version", "v", "active", "Value mapping version")
+}
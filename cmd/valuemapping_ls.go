@@ -0,0 +1,35 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// valueMappingLsCmd represents the valueMappingLs command
+var valueMappingLsCmd = &cobra.Command{
+	Use:   "ls package-id",
+	Short: "Get all value mappings of the integration package",
+	Long:  `You can use the following subcommand to get all value mappings of designtime of the specified package-id.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) == 0 {
+			log.Fatal("Required parameter package-id not set")
+		}
+		client.RunGetValueMappingsOfIntegrationPackage(os.Stdout, conf, args[0])
+	},
+}
+
+func init() {
+	valueMappingCmd.AddCommand(valueMappingLsCmd)
+}
@@ -0,0 +1,51 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// valueMappingTransportCmd represents the valueMappingTransport command
+var valueMappingTransportCmd = &cobra.Command{
+	Use:   "transport [source-valuemapping-id] [destination-valuemapping-id]",
+	Short: "Transport a value mapping between systems",
+	Long: `You can use the following subcommand to transport
+a value mapping of designtime between systems. `,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			log.Fatal("Required parameter source-valuemapping-id not set")
+		}
+		if len(args) == 1 {
+			log.Fatal("Required parameter destination-valuemapping-id not set")
+		}
+		destTenantKey, _ := cmd.Flags().GetString("dest-tenant-key")
+		if destTenantKey == "" {
+			log.Fatal("Required flag dest-tenant-key not set")
+		}
+
+		destName, _ := cmd.Flags().GetString("dest-name")
+		destPackageID, _ := cmd.Flags().GetString("dest-package-id")
+
+		client.RunTransportValueMapping(os.Stdout, conf, args[0], args[1], destTenantKey, destName, destPackageID)
+	},
+}
+
+func init() {
+	valueMappingCmd.AddCommand(valueMappingTransportCmd)
+	valueMappingTransportCmd.Flags().StringP("dest-tenant-key", "d", "", "Destination tenant key from configuration file")
+	valueMappingTransportCmd.Flags().StringP("dest-name", "n", "", "Destination value mapping name")
+	valueMappingTransportCmd.Flags().StringP("dest-package-id", "p", "", "Destination value mapping package id")
+}
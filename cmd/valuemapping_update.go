@@ -0,0 +1,42 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// valueMappingUpdateCmd represents the valueMappingUpdate command
+var valueMappingUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a value mapping",
+	Long:  `You can use the following command to update a value mapping from designtime`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		id, _ := cmd.Flags().GetString("id")
+		fileName, _ := cmd.Flags().GetString("content-file-name")
+		version, _ := cmd.Flags().GetString("version")
+
+		client.RunUpdateValueMapping(os.Stdout, conf, name, id, version, fileName)
+	},
+}
+
+func init() {
+	valueMappingCmd.AddCommand(valueMappingUpdateCmd)
+	valueMappingUpdateCmd.Flags().StringP("name", "n", "", "Value mapping name")
+	valueMappingUpdateCmd.Flags().StringP("id", "i", "", "Value mapping id")
+	valueMappingUpdateCmd.Flags().StringP("version", "v", "active", "Value mapping version")
+	valueMappingUpdateCmd.Flags().StringP("content-file-name", "f", "", "Value mapping artifact content file (.zip)")
+}
@@ -0,0 +1,20 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// variableCmd represents the variable command
+var variableCmd = &cobra.Command{
+	Use:     "variable",
+	Aliases: []string{"var"},
+	Short:   "Command related to the global and local variables of the runtime",
+}
+
+func init() {
+	rootCmd.AddCommand(variableCmd)
+}
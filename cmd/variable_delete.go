@@ -0,0 +1,36 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// variableDeleteCmd represents the delete command
+var variableDeleteCmd = &cobra.Command{
+	Use:   "delete name",
+	Short: "Delete variable by name",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter name not set")
+		}
+		flowID, _ := cmd.Flags().GetString("flow")
+		client.RunDeleteVariable(os.Stdout, conf, args[0], flowID)
+	},
+}
+
+func init() {
+	variableCmd.AddCommand(variableDeleteCmd)
+	variableDeleteCmd.Flags().StringP("flow", "i", "", "Integration flow id of the local variable (needed when the name is not unique)")
+}
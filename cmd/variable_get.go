@@ -0,0 +1,38 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// variableGetCmd represents the get command
+var variableGetCmd = &cobra.Command{
+	Use:   "get name",
+	Short: "Get variable by name",
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter name not set")
+		}
+		flowID, _ := cmd.Flags().GetString("flow")
+		saveFile, _ := cmd.Flags().GetString("save")
+		client.RunGetVariable(os.Stdout, conf, args[0], flowID, saveFile)
+	},
+}
+
+func init() {
+	variableCmd.AddCommand(variableGetCmd)
+	variableGetCmd.Flags().StringP("flow", "i", "", "Integration flow id of the local variable (needed when the name is not unique)")
+	variableGetCmd.Flags().StringP("save", "s", "", "Download the content of the variable (zip archive) to the file")
+}
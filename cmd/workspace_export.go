@@ -0,0 +1,40 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// workspaceExportCmd represents the export command
+var workspaceExportCmd = &cobra.Command{
+	Use:   "export dir",
+	Short: "Export all designtime artifacts of the tenant into the directory",
+	Long: `You can use the following command to extract all designtime artifacts of the tenant
+into the directory dir/package-id/artifact-id/, e.g. for the nightly backup in git.
+The metadata of the package is written to dir/package-id/package.json and the configurations
+of the integration flows to dir/package-id/flow-id.configurations.json.
+Packages and artifacts which no longer exist in the tenant are removed from the directory, e.g.:
+cig workspace export backup`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(args) < 1 {
+			log.Fatal("Required parameter dir not set")
+		}
+		client.RunExportWorkspace(os.Stdout, conf, args[0])
+	},
+}
+
+func init() {
+	workspaceCmd.AddCommand(workspaceExportCmd)
+}
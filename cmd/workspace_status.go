@@ -0,0 +1,38 @@
+/*
+Copyright © 2022 NAME HERE <EMAIL ADDRESS>
+
+*/
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tobiaszgithub/cig/client"
+	"github.com/tobiaszgithub/cig/config"
+)
+
+// workspaceStatusCmd represents the status command
+var workspaceStatusCmd = &cobra.Command{
+	Use:   "status [dir]",
+	Short: "Compare the workspace directory with the tenant",
+	Long: `You can use the following command to list the artifacts which were modified, added
+or deleted in the tenant since the directory was exported [default value current directory], e.g.:
+cig workspace status backup`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, err := config.NewConfiguration(TenantKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		dir := "."
+		if len(args) > 0 {
+			dir = args[0]
+		}
+		client.RunGetWorkspaceStatus(os.Stdout, conf, dir)
+	},
+}
+
+func init() {
+	workspaceCmd.AddCommand(workspaceStatusCmd)
+}
@@ -5,6 +5,7 @@ go 1.18
 require (
 	github.com/spf13/cobra v1.6.1
 	golang.org/x/oauth2 v0.0.0-20220524215830-622c5d57e401
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
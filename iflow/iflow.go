@@ -0,0 +1,277 @@
+//Package iflow parses the archive of the integration flow into a typed model
+//and writes it back as zip archive which can be uploaded to the tenant.
+package iflow
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+var (
+	//ErrInvalid - the archive or the file of the archive is not valid
+	ErrInvalid = errors.New("invalid flow archive")
+)
+
+const (
+	ManifestPath             = "META-INF/MANIFEST.MF"
+	ProjectPath              = ".project"
+	ResourcesDir             = "src/main/resources/"
+	ModelDir                 = ResourcesDir + "scenarioflows/integrationflow/"
+	ParametersPath           = ResourcesDir + "parameters.prop"
+	ParameterDefinitionsPath = ResourcesDir + "parameters.propdef"
+)
+
+//File - file of the archive, the name is the slash separated path in the archive
+type File struct {
+	Name     string
+	Modified time.Time
+	Content  []byte
+}
+
+//IsDir reports whether the file is a directory entry of the archive
+func (f *File) IsDir() bool {
+	return strings.HasSuffix(f.Name, "/")
+}
+
+//Resource - file of src/main/resources, the type is the folder, e.g. script, mapping, xsd, wsdl, json, lib
+type Resource struct {
+	Type string
+	*File
+}
+
+//Flow - the archive of the integration flow. Files keeps all files in the order of the archive,
+//Manifest, Project and Parameters are written back to their files by Write.
+type Flow struct {
+	Manifest             *Manifest
+	Project              *Project
+	Model                *Model
+	Parameters           *Properties
+	ParameterDefinitions []ParameterDefinition
+	Files                []*File
+}
+
+//ReadFile reads the flow from the zip file
+func ReadFile(fileName string) (*Flow, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return Read(content)
+}
+
+//Read reads the flow from the content of the zip archive
+func Read(content []byte) (*Flow, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	}
+
+	var files []*File
+	for _, f := range zipReader.File {
+		if !validName(f.Name) {
+			return nil, fmt.Errorf("%w: %s: path outside of the archive", ErrInvalid, f.Name)
+		}
+		file := &File{Name: f.Name, Modified: f.Modified}
+		if !file.IsDir() {
+			rc, err := f.Open()
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %s", ErrInvalid, f.Name, err)
+			}
+			file.Content, err = io.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %s", ErrInvalid, f.Name, err)
+			}
+		}
+		files = append(files, file)
+	}
+	return New(files)
+}
+
+//validName reports whether the name is a relative path inside of the archive, names like my..script.groovy are valid
+func validName(name string) bool {
+	if strings.HasPrefix(name, "/") || strings.HasPrefix(name, `\`) {
+		return false
+	}
+	for _, segment := range strings.FieldsFunc(name, func(r rune) bool { return r == '/' || r == '\\' }) {
+		if segment == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+//New parses the files of the flow, the manifest is required
+func New(files []*File) (*Flow, error) {
+	flow := &Flow{Files: files}
+
+	manifest := flow.File(ManifestPath)
+	if manifest == nil {
+		return nil, fmt.Errorf("%w: %s not found", ErrInvalid, ManifestPath)
+	}
+	var err error
+	if flow.Manifest, err = ParseManifest(manifest.Content); err != nil {
+		return nil, err
+	}
+
+	if f := flow.File(ProjectPath); f != nil {
+		if flow.Project, err = ParseProject(f.Content); err != nil {
+			return nil, err
+		}
+	}
+	if f := flow.ModelFile(); f != nil {
+		if flow.Model, err = ParseModel(f.Content); err != nil {
+			return nil, err
+		}
+	}
+	if f := flow.File(ParametersPath); f != nil {
+		flow.Parameters = ParseProperties(f.Content)
+	}
+	if f := flow.File(ParameterDefinitionsPath); f != nil {
+		if flow.ParameterDefinitions, err = ParseParameterDefinitions(f.Content); err != nil {
+			return nil, err
+		}
+	}
+	return flow, nil
+}
+
+//File returns the file of the archive or nil
+func (flow *Flow) File(name string) *File {
+	for _, f := range flow.Files {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+//ModelFile returns the .iflw file or nil
+func (flow *Flow) ModelFile() *File {
+	for _, f := range flow.Files {
+		if strings.HasPrefix(f.Name, ModelDir) && strings.HasSuffix(f.Name, ".iflw") {
+			return f
+		}
+	}
+	return nil
+}
+
+//Resources returns the files of src/main/resources except of the model and the parameters
+func (flow *Flow) Resources() []Resource {
+	var resources []Resource
+	for _, f := range flow.Files {
+		if f.IsDir() || !strings.HasPrefix(f.Name, ResourcesDir) {
+			continue
+		}
+		if f.Name == ParametersPath || f.Name == ParameterDefinitionsPath || strings.HasPrefix(f.Name, ModelDir) {
+			continue
+		}
+		resourceType := ""
+		if dir := path.Dir(strings.TrimPrefix(f.Name, ResourcesDir)); dir != "." {
+			resourceType = strings.Split(dir, "/")[0]
+		}
+		resources = append(resources, Resource{Type: resourceType, File: f})
+	}
+	return resources
+}
+
+//SetFile sets the content of the file, the file is appended when missing
+func (flow *Flow) SetFile(name string, content []byte) {
+	if f := flow.File(name); f != nil {
+		f.Content = content
+		return
+	}
+	flow.Files = append(flow.Files, &File{Name: name, Modified: time.Now(), Content: content})
+}
+
+//RemoveFile removes the file from the archive
+func (flow *Flow) RemoveFile(name string) {
+	for i, f := range flow.Files {
+		if f.Name == name {
+			flow.Files = append(flow.Files[:i], flow.Files[i+1:]...)
+			return
+		}
+	}
+}
+
+//ID returns the Bundle-SymbolicName of the manifest without the directives, which is the id of the flow
+func (flow *Flow) ID() string {
+	return flow.Manifest.SymbolicName()
+}
+
+//SetID sets the id of the flow in the manifest and in the .project
+func (flow *Flow) SetID(id string) {
+	flow.Manifest.SetSymbolicName(id)
+	if flow.Project != nil {
+		flow.Project.Name = id
+	}
+}
+
+//Name returns the Bundle-Name of the manifest, which is the name of the flow
+func (flow *Flow) Name() string {
+	return flow.Manifest.Get("Bundle-Name")
+}
+
+//SetName sets the name of the flow in the manifest
+func (flow *Flow) SetName(name string) {
+	flow.Manifest.Set("Bundle-Name", name)
+}
+
+//content returns the content of the file, the typed files are serialized
+func (flow *Flow) content(f *File) []byte {
+	switch {
+	case f.Name == ManifestPath && flow.Manifest != nil:
+		return flow.Manifest.Bytes()
+	case f.Name == ProjectPath && flow.Project != nil:
+		return flow.Project.Bytes()
+	case f.Name == ParametersPath && flow.Parameters != nil:
+		return flow.Parameters.Bytes()
+	}
+	return f.Content
+}
+
+//Write writes the flow as zip archive
+func (flow *Flow) Write(w io.Writer) error {
+	writer := zip.NewWriter(w)
+	for _, f := range flow.Files {
+		header := &zip.FileHeader{Name: f.Name, Method: zip.Deflate, Modified: f.Modified}
+		if f.IsDir() {
+			header.Method = zip.Store
+		}
+		fw, err := writer.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if f.IsDir() {
+			continue
+		}
+		if _, err := fw.Write(flow.content(f)); err != nil {
+			return err
+		}
+	}
+	return writer.Close()
+}
+
+//Bytes returns the flow as zip archive
+func (flow *Flow) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := flow.Write(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+//WriteFile writes the flow to the zip file
+func (flow *Flow) WriteFile(fileName string) error {
+	content, err := flow.Bytes()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, content, 0666)
+}
@@ -0,0 +1,277 @@
+package iflow_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/iflow"
+)
+
+const testManifest = "Manifest-Version: 1.0\r\n" +
+	"Bundle-SymbolicName: Replicate_Purchase_Orders_From_S4HANA_To_Partner_\r\n" +
+	" System; singleton:=true\r\n" +
+	"Bundle-Name: Replicate Purchase Orders\r\n" +
+	"Bundle-Version: 1.0.3\r\n" +
+	"SAP-BundleType: IntegrationFlow\r\n" +
+	"\r\n"
+
+const testProject = `<?xml version="1.0" encoding="UTF-8"?><projectDescription>
+	<name>Replicate_Purchase_Orders_From_S4HANA_To_Partner_System</name>
+	<comment/>
+	<natures>
+		<nature>org.eclipse.jdt.core.javanature</nature>
+		<nature>com.sap.ide.ifl.project.support.project.nature</nature>
+	</natures>
+</projectDescription>
+`
+
+const testModel = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn2:definitions xmlns:bpmn2="http://www.omg.org/spec/BPMN/20100524/MODEL" xmlns:ifl="http:///com.sap.ifl.model/Ifl.xsd" id="Definitions_1">
+	<bpmn2:collaboration id="Collaboration_1" name="Default Collaboration">
+		<bpmn2:extensionElements>
+			<ifl:property><key>namespaceMapping</key><value/></ifl:property>
+		</bpmn2:extensionElements>
+		<bpmn2:participant id="Participant_1" ifl:type="EndpointSender" name="S4HANA">
+			<bpmn2:extensionElements><ifl:property><key>enableBasicAuthentication</key><value>false</value></ifl:property></bpmn2:extensionElements>
+		</bpmn2:participant>
+		<bpmn2:participant id="Participant_2" ifl:type="EndpointRecevier" name="Partner"/>
+		<bpmn2:participant id="Participant_Process_1" ifl:type="IntegrationProcess" name="Integration Process" processRef="Process_1"/>
+		<bpmn2:messageFlow id="MessageFlow_1" name="IDOC" sourceRef="Participant_1" targetRef="StartEvent_2">
+			<bpmn2:extensionElements>
+				<ifl:property><key>ComponentType</key><value>IDOC</value></ifl:property>
+				<ifl:property><key>direction</key><value>Sender</value></ifl:property>
+			</bpmn2:extensionElements>
+		</bpmn2:messageFlow>
+		<bpmn2:messageFlow id="MessageFlow_2" name="SFTP" sourceRef="EndEvent_2" targetRef="Participant_2">
+			<bpmn2:extensionElements>
+				<ifl:property><key>ComponentType</key><value>SFTP</value></ifl:property>
+				<ifl:property><key>direction</key><value>Receiver</value></ifl:property>
+				<ifl:property><key>host</key><value>{{SFTP_Host}}</value></ifl:property>
+			</bpmn2:extensionElements>
+		</bpmn2:messageFlow>
+	</bpmn2:collaboration>
+	<bpmn2:process id="Process_1" name="Integration Process">
+		<bpmn2:startEvent id="StartEvent_2" name="Start"><bpmn2:outgoing>SequenceFlow_1</bpmn2:outgoing></bpmn2:startEvent>
+		<bpmn2:callActivity id="CallActivity_1" name="Map Order">
+			<bpmn2:extensionElements>
+				<ifl:property><key>activityType</key><value>Mapping</value></ifl:property>
+				<ifl:property><key>mappingpath</key><value>src/main/resources/mapping/Order</value></ifl:property>
+			</bpmn2:extensionElements>
+		</bpmn2:callActivity>
+		<bpmn2:subProcess id="SubProcess_1" name="Exception Subprocess">
+			<bpmn2:callActivity id="CallActivity_2" name="Log Error">
+				<bpmn2:extensionElements><ifl:property><key>activityType</key><value>Script</value></ifl:property></bpmn2:extensionElements>
+			</bpmn2:callActivity>
+		</bpmn2:subProcess>
+		<bpmn2:endEvent id="EndEvent_2" name="End"/>
+		<bpmn2:sequenceFlow id="SequenceFlow_1" sourceRef="StartEvent_2" targetRef="CallActivity_1"/>
+	</bpmn2:process>
+</bpmn2:definitions>
+`
+
+const testParameters = "#Store parameters\n#Mon Oct 10 10:00:00 UTC 2022\nSFTP_Host=sftp.example.com\\:22\nGreeting=Gr\\u00FC\\u00DF Gott\n"
+
+const testParameterDefinitions = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<parameters>
+	<parameter><key>SFTP_Host</key><name>SFTP_Host</name><type>xsd:string</type><isRequired>true</isRequired><description>Host of the partner</description></parameter>
+	<parameter><key>Greeting</key><name>Greeting</name><type>xsd:string</type><isRequired>false</isRequired><description/></parameter>
+</parameters>
+`
+
+var testFiles = []struct {
+	name    string
+	content string
+}{
+	{"META-INF/MANIFEST.MF", testManifest},
+	{".project", testProject},
+	{"metainfo.prop", "#Mon Oct 10 10:00:00 UTC 2022\ndescription=Orders\n"},
+	{"src/main/resources/", ""},
+	{"src/main/resources/scenarioflows/integrationflow/Replicate_Purchase_Orders.iflw", testModel},
+	{"src/main/resources/parameters.prop", testParameters},
+	{"src/main/resources/parameters.propdef", testParameterDefinitions},
+	{"src/main/resources/script/logError.groovy", "def Message processData(Message message) { return message }\n"},
+	{"src/main/resources/mapping/Order.mmap", "<mapping/>"},
+}
+
+func testArchive(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range testFiles {
+		fw, err := w.Create(f.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write([]byte(f.content))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestRead(t *testing.T) {
+	flow, err := iflow.Read(testArchive(t))
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	if id := flow.ID(); id != "Replicate_Purchase_Orders_From_S4HANA_To_Partner_System" {
+		t.Errorf("Unexpected flow id %q", id)
+	}
+	if name := flow.Manifest.Get("Bundle-Name"); name != "Replicate Purchase Orders" {
+		t.Errorf("Unexpected Bundle-Name %q", name)
+	}
+	if flow.Project.Name != flow.ID() {
+		t.Errorf("Unexpected project name %q", flow.Project.Name)
+	}
+
+	var participants []string
+	for _, p := range flow.Model.Participants {
+		participants = append(participants, p.Name+"/"+p.Type)
+	}
+	expParticipants := []string{"S4HANA/EndpointSender", "Partner/EndpointRecevier", "Integration Process/IntegrationProcess"}
+	if !reflect.DeepEqual(participants, expParticipants) {
+		t.Errorf("Expected participants %v, got %v", expParticipants, participants)
+	}
+
+	if adapters := flow.Model.Adapters(); !reflect.DeepEqual(adapters, []string{"IDOC", "SFTP"}) {
+		t.Errorf("Unexpected adapters %v", adapters)
+	}
+	if host := flow.Model.Channels[1].Properties.Get("host"); host != "{{SFTP_Host}}" || flow.Model.Channels[1].Direction != "Receiver" {
+		t.Errorf("Unexpected receiver channel %+v", flow.Model.Channels[1])
+	}
+
+	var steps []string
+	for _, s := range flow.Model.AllSteps() {
+		steps = append(steps, fmt.Sprintf("%s:%s:%s", s.Kind, s.ID, s.ActivityType))
+	}
+	expSteps := []string{"startEvent:StartEvent_2:", "callActivity:CallActivity_1:Mapping", "subProcess:SubProcess_1:",
+		"callActivity:CallActivity_2:Script", "endEvent:EndEvent_2:"}
+	if !reflect.DeepEqual(steps, expSteps) {
+		t.Errorf("Expected steps %v, got %v", expSteps, steps)
+	}
+
+	if v, _ := flow.Parameters.Get("SFTP_Host"); v != "sftp.example.com:22" {
+		t.Errorf("Unexpected parameter SFTP_Host %q", v)
+	}
+	if v, _ := flow.Parameters.Get("Greeting"); v != "Grüß Gott" {
+		t.Errorf("Unexpected parameter Greeting %q", v)
+	}
+	if len(flow.ParameterDefinitions) != 2 || !flow.ParameterDefinitions[0].Required || flow.ParameterDefinitions[0].Type != "xsd:string" {
+		t.Errorf("Unexpected parameter definitions %+v", flow.ParameterDefinitions)
+	}
+
+	var resources []string
+	for _, r := range flow.Resources() {
+		resources = append(resources, r.Type+":"+r.Name)
+	}
+	expResources := []string{"script:src/main/resources/script/logError.groovy", "mapping:src/main/resources/mapping/Order.mmap"}
+	if !reflect.DeepEqual(resources, expResources) {
+		t.Errorf("Expected resources %v, got %v", expResources, resources)
+	}
+}
+
+func TestReadNames(t *testing.T) {
+	archive := func(name string) []byte {
+		var buf bytes.Buffer
+		w := zip.NewWriter(&buf)
+		for _, f := range testFiles {
+			fw, _ := w.Create(f.name)
+			fw.Write([]byte(f.content))
+		}
+		fw, _ := w.Create(name)
+		fw.Write([]byte("content"))
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	flow, err := iflow.Read(archive("src/main/resources/script/my..script.groovy"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	var buf bytes.Buffer
+	if err := flow.Write(&buf); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	written, err := iflow.Read(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if f := written.File("src/main/resources/script/my..script.groovy"); f == nil || string(f.Content) != "content" {
+		t.Errorf("Expected my..script.groovy to be written back, got %v", f)
+	}
+
+	for _, name := range []string{"../evil.groovy", "src/main/resources/../../evil.groovy", "/etc/evil", `src\..\..\evil`} {
+		if _, err := iflow.Read(archive(name)); !errors.Is(err, iflow.ErrInvalid) {
+			t.Errorf("%s: expected error %q, got %q.", name, iflow.ErrInvalid, err)
+		}
+	}
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	flow, err := iflow.Read(testArchive(t))
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	content, err := flow.Bytes()
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	written, err := iflow.Read(content)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	if len(written.Files) != len(testFiles) {
+		t.Fatalf("Expected %d files, got %d", len(testFiles), len(written.Files))
+	}
+	for i, f := range testFiles {
+		if written.Files[i].Name != f.name {
+			t.Errorf("Expected file %s, got %s", f.name, written.Files[i].Name)
+		}
+		if string(written.Files[i].Content) != f.content {
+			t.Errorf("Content of %s changed:\n%q\n%q", f.name, f.content, written.Files[i].Content)
+		}
+	}
+}
+
+func TestWriteChanged(t *testing.T) {
+	flow, err := iflow.Read(testArchive(t))
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	flow.Project.Name = "Orders_Copy"
+	flow.Parameters.Set("SFTP_Host", "sftp.partner.com:2222")
+	flow.Parameters.Set("Timeout", "60")
+	flow.SetFile("src/main/resources/script/logError.groovy", []byte("// changed\n"))
+
+	content, err := flow.Bytes()
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	written, err := iflow.Read(content)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+
+	if written.Project.Name != "Orders_Copy" {
+		t.Errorf("Unexpected project name %q", written.Project.Name)
+	}
+	expParameters := "#Store parameters\n#Mon Oct 10 10:00:00 UTC 2022\nSFTP_Host=sftp.partner.com\\:2222\nGreeting=Gr\\u00FC\\u00DF Gott\nTimeout=60\n"
+	if got := string(written.File(iflow.ParametersPath).Content); got != expParameters {
+		t.Errorf("Expected parameters:\n%s\ngot:\n%s", expParameters, got)
+	}
+	if got := string(written.File("src/main/resources/script/logError.groovy").Content); !strings.HasPrefix(got, "// changed") {
+		t.Errorf("Unexpected script %q", got)
+	}
+}
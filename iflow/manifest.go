@@ -0,0 +1,147 @@
+package iflow
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+//maxManifestLineLength - no line may be longer than 72 bytes (not characters), in its UTF8-encoded form.
+//The line break is included, the tenant writes 70 bytes of the header per line followed by CRLF.
+const maxManifestLineLength = 72 - len("\r\n")
+
+//Header - header of the manifest, the value is unfolded
+type Header struct {
+	Name  string
+	Value string
+}
+
+//Manifest - META-INF/MANIFEST.MF of the flow bundle. Headers is the main section,
+//Sections are the following per-entry sections, which are rarely used in flows.
+type Manifest struct {
+	Headers  []Header
+	Sections [][]Header
+	newline  string
+}
+
+//ParseManifest parses the manifest and unfolds the continuation lines
+func ParseManifest(content []byte) (*Manifest, error) {
+	m := &Manifest{newline: "\r\n"}
+	text := string(content)
+	if !strings.Contains(text, "\r\n") && strings.Contains(text, "\n") {
+		m.newline = "\n"
+	}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	text = strings.TrimPrefix(text, "\ufeff")
+
+	var section []Header
+	sectionStarted := false
+	endSection := func() {
+		if !sectionStarted {
+			return
+		}
+		if m.Headers == nil && len(m.Sections) == 0 {
+			m.Headers = section
+		} else {
+			m.Sections = append(m.Sections, section)
+		}
+		section = nil
+		sectionStarted = false
+	}
+
+	for i, line := range strings.Split(text, "\n") {
+		switch {
+		case line == "":
+			endSection()
+		case line[0] == ' ':
+			if len(section) == 0 {
+				return nil, fmt.Errorf("%w: MANIFEST.MF line %d: continuation line without header", ErrInvalid, i+1)
+			}
+			section[len(section)-1].Value += line[1:]
+		default:
+			name, value, found := strings.Cut(line, ":")
+			if !found || name == "" {
+				return nil, fmt.Errorf("%w: MANIFEST.MF line %d: invalid header %s", ErrInvalid, i+1, line)
+			}
+			section = append(section, Header{Name: name, Value: strings.TrimPrefix(value, " ")})
+			sectionStarted = true
+		}
+	}
+	endSection()
+
+	return m, nil
+}
+
+//Get returns the value of the header of the main section
+func (m *Manifest) Get(name string) string {
+	for _, h := range m.Headers {
+		if strings.EqualFold(h.Name, name) {
+			return h.Value
+		}
+	}
+	return ""
+}
+
+//Set sets the value of the header of the main section, the header is appended when missing
+func (m *Manifest) Set(name string, value string) {
+	for i, h := range m.Headers {
+		if strings.EqualFold(h.Name, name) {
+			m.Headers[i].Value = value
+			return
+		}
+	}
+	m.Headers = append(m.Headers, Header{Name: name, Value: value})
+}
+
+//SymbolicName returns the Bundle-SymbolicName without the directives, e.g. singleton:=true
+func (m *Manifest) SymbolicName() string {
+	name, _, _ := strings.Cut(m.Get("Bundle-SymbolicName"), ";")
+	return strings.TrimSpace(name)
+}
+
+//SetSymbolicName sets the Bundle-SymbolicName, the directives are kept
+func (m *Manifest) SetSymbolicName(name string) {
+	_, directives, found := strings.Cut(m.Get("Bundle-SymbolicName"), ";")
+	if found {
+		name += ";" + directives
+	}
+	m.Set("Bundle-SymbolicName", name)
+}
+
+//Bytes returns the manifest with the lines folded to 72 bytes
+func (m *Manifest) Bytes() []byte {
+	newline := m.newline
+	if newline == "" {
+		newline = "\r\n"
+	}
+
+	var buf bytes.Buffer
+	for _, section := range append([][]Header{m.Headers}, m.Sections...) {
+		for _, h := range section {
+			writeManifestHeader(&buf, h.Name+": "+h.Value, newline)
+		}
+		buf.WriteString(newline)
+	}
+	return buf.Bytes()
+}
+
+//writeManifestHeader folds the header, the continuation lines start with a space.
+//The lines are not split inside of the multibyte UTF-8 character.
+func writeManifestHeader(buf *bytes.Buffer, line string, newline string) {
+	limit := maxManifestLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString(newline)
+		buf.WriteString(" ")
+		line = line[cut:]
+		limit = maxManifestLineLength - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString(newline)
+}
@@ -0,0 +1,74 @@
+package iflow_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/tobiaszgithub/cig/iflow"
+)
+
+func TestManifestRewrite(t *testing.T) {
+	testCases := []struct {
+		name         string
+		symbolicName string
+		bundleName   string
+	}{
+		{
+			name:         "short",
+			symbolicName: "Orders",
+			bundleName:   "Orders",
+		},
+		{
+			name:         "long",
+			symbolicName: strings.Repeat("Replicate_Purchase_Orders_From_S4HANA_", 5) + "To_Partner_System",
+			bundleName:   strings.Repeat("Replicate Purchase Orders From S4HANA ", 4) + "To Partner System",
+		},
+		{
+			name:         "multibyte",
+			symbolicName: "Bestellungen_Überprüfung_" + strings.Repeat("Größenänderung_", 6),
+			bundleName:   strings.Repeat("注文の複製と検証", 10),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := iflow.ParseManifest([]byte(testManifest))
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			m.SetSymbolicName(tc.symbolicName)
+			m.Set("Bundle-Name", tc.bundleName)
+
+			content := string(m.Bytes())
+			if !strings.HasSuffix(content, "\r\n\r\n") {
+				t.Errorf("Manifest should end with empty line:\n%q", content)
+			}
+			for _, line := range strings.Split(strings.TrimSuffix(content, "\r\n"), "\r\n") {
+				if len(line) > 70 {
+					t.Errorf("Line longer than 72 bytes with CRLF (%d): %q", len(line), line)
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("Line splits multibyte character: %q", line)
+				}
+			}
+
+			written, err := iflow.ParseManifest([]byte(content))
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if got := written.SymbolicName(); got != tc.symbolicName {
+				t.Errorf("Expected symbolic name %q, got %q", tc.symbolicName, got)
+			}
+			if got := written.Get("Bundle-SymbolicName"); got != tc.symbolicName+"; singleton:=true" {
+				t.Errorf("Directives not kept: %q", got)
+			}
+			if got := written.Get("Bundle-Name"); got != tc.bundleName {
+				t.Errorf("Expected Bundle-Name %q, got %q", tc.bundleName, got)
+			}
+			if got := written.Get("Bundle-Version"); got != "1.0.3" {
+				t.Errorf("Unexpected Bundle-Version %q", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package iflow
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+)
+
+//Property - ifl:property of the extension elements, the configuration of the BPMN element
+type Property struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+//PropertyList - properties of the BPMN element in the order of the file
+type PropertyList []Property
+
+//Get returns the value of the property
+func (l PropertyList) Get(key string) string {
+	for _, p := range l {
+		if p.Key == key {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+//Participant - sender, receiver or integration process of the collaboration
+type Participant struct {
+	ID         string
+	Name       string
+	Type       string
+	ProcessRef string
+	Properties PropertyList
+}
+
+//Channel - message flow between the participant and the process, the adapter is the component type
+type Channel struct {
+	ID         string
+	Name       string
+	SourceRef  string
+	TargetRef  string
+	Adapter    string
+	Direction  string
+	Properties PropertyList
+}
+
+//Step - flow step of the process, e.g. callActivity with activityType Script or Mapping
+type Step struct {
+	ID           string
+	Name         string
+	Kind         string
+	ActivityType string
+	Properties   PropertyList
+	Steps        []Step
+}
+
+//Process - integration process or local integration process
+type Process struct {
+	ID         string
+	Name       string
+	Properties PropertyList
+	Steps      []Step
+}
+
+//Model - typed view of the .iflw BPMN file
+type Model struct {
+	Properties   PropertyList
+	Participants []Participant
+	Channels     []Channel
+	Processes    []Process
+}
+
+type bpmnExtension struct {
+	Properties PropertyList `xml:"property"`
+}
+
+type bpmnElement struct {
+	XMLName   xml.Name
+	ID        string        `xml:"id,attr"`
+	Name      string        `xml:"name,attr"`
+	Extension bpmnExtension `xml:"extensionElements"`
+	Children  []bpmnElement `xml:",any"`
+}
+
+type bpmnDefinitions struct {
+	Collaboration struct {
+		Extension    bpmnExtension `xml:"extensionElements"`
+		Participants []struct {
+			ID         string        `xml:"id,attr"`
+			Name       string        `xml:"name,attr"`
+			Type       string        `xml:"type,attr"`
+			ProcessRef string        `xml:"processRef,attr"`
+			Extension  bpmnExtension `xml:"extensionElements"`
+		} `xml:"participant"`
+		MessageFlows []struct {
+			ID        string        `xml:"id,attr"`
+			Name      string        `xml:"name,attr"`
+			SourceRef string        `xml:"sourceRef,attr"`
+			TargetRef string        `xml:"targetRef,attr"`
+			Extension bpmnExtension `xml:"extensionElements"`
+		} `xml:"messageFlow"`
+	} `xml:"collaboration"`
+	Processes []struct {
+		ID        string        `xml:"id,attr"`
+		Name      string        `xml:"name,attr"`
+		Extension bpmnExtension `xml:"extensionElements"`
+		Elements  []bpmnElement `xml:",any"`
+	} `xml:"process"`
+}
+
+//ParseModel parses the .iflw file
+func ParseModel(content []byte) (*Model, error) {
+	var d bpmnDefinitions
+	if err := xml.Unmarshal(content, &d); err != nil {
+		return nil, fmt.Errorf("%w: iflw: %s", ErrInvalid, err)
+	}
+
+	m := &Model{Properties: d.Collaboration.Extension.Properties}
+	for _, p := range d.Collaboration.Participants {
+		m.Participants = append(m.Participants, Participant{
+			ID:         p.ID,
+			Name:       p.Name,
+			Type:       p.Type,
+			ProcessRef: p.ProcessRef,
+			Properties: p.Extension.Properties,
+		})
+	}
+	for _, f := range d.Collaboration.MessageFlows {
+		m.Channels = append(m.Channels, Channel{
+			ID:         f.ID,
+			Name:       f.Name,
+			SourceRef:  f.SourceRef,
+			TargetRef:  f.TargetRef,
+			Adapter:    f.Extension.Properties.Get("ComponentType"),
+			Direction:  f.Extension.Properties.Get("direction"),
+			Properties: f.Extension.Properties,
+		})
+	}
+	for _, p := range d.Processes {
+		m.Processes = append(m.Processes, Process{
+			ID:         p.ID,
+			Name:       p.Name,
+			Properties: p.Extension.Properties,
+			Steps:      bpmnSteps(p.Elements),
+		})
+	}
+	return m, nil
+}
+
+//bpmnSteps converts the elements with id to steps, sequence flows are connections and not steps
+func bpmnSteps(elements []bpmnElement) []Step {
+	var steps []Step
+	for _, e := range elements {
+		if e.ID == "" || e.XMLName.Local == "sequenceFlow" {
+			continue
+		}
+		steps = append(steps, Step{
+			ID:           e.ID,
+			Name:         e.Name,
+			Kind:         e.XMLName.Local,
+			ActivityType: e.Extension.Properties.Get("activityType"),
+			Properties:   e.Extension.Properties,
+			Steps:        bpmnSteps(e.Children),
+		})
+	}
+	return steps
+}
+
+//Adapters returns the sorted distinct adapter types of the channels
+func (m *Model) Adapters() []string {
+	seen := map[string]bool{}
+	var adapters []string
+	for _, c := range m.Channels {
+		if c.Adapter == "" || seen[c.Adapter] {
+			continue
+		}
+		seen[c.Adapter] = true
+		adapters = append(adapters, c.Adapter)
+	}
+	sort.Strings(adapters)
+	return adapters
+}
+
+//AllSteps returns the steps of all processes including the steps nested in sub-processes
+func (m *Model) AllSteps() []Step {
+	var all []Step
+	var walk func(steps []Step)
+	walk = func(steps []Step) {
+		for _, s := range steps {
+			all = append(all, s)
+			walk(s.Steps)
+		}
+	}
+	for _, p := range m.Processes {
+		walk(p.Steps)
+	}
+	return all
+}
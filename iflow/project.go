@@ -0,0 +1,51 @@
+package iflow
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+)
+
+//Project - Eclipse .project of the flow, the name of the project is the flow id
+type Project struct {
+	Name     string
+	Comment  string
+	Natures  []string
+	raw      []byte
+	origName string
+}
+
+type projectDescription struct {
+	Name    string   `xml:"name"`
+	Comment string   `xml:"comment"`
+	Natures []string `xml:"natures>nature"`
+}
+
+//ParseProject parses the .project file
+func ParseProject(content []byte) (*Project, error) {
+	var d projectDescription
+	if err := xml.Unmarshal(content, &d); err != nil {
+		return nil, fmt.Errorf("%w: .project: %s", ErrInvalid, err)
+	}
+	return &Project{
+		Name:     d.Name,
+		Comment:  d.Comment,
+		Natures:  d.Natures,
+		raw:      content,
+		origName: d.Name,
+	}, nil
+}
+
+//Bytes returns the .project file. The original content is kept, only the name of the project is replaced when changed.
+func (p *Project) Bytes() []byte {
+	if p.Name == p.origName {
+		return p.raw
+	}
+	return bytes.Replace(p.raw, []byte("<name>"+escapeXML(p.origName)+"</name>"), []byte("<name>"+escapeXML(p.Name)+"</name>"), 1)
+}
+
+func escapeXML(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -0,0 +1,26 @@
+package iflow
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+//ParameterDefinition - definition of the externalized parameter from parameters.propdef
+type ParameterDefinition struct {
+	Key         string `xml:"key"`
+	Name        string `xml:"name"`
+	Type        string `xml:"type"`
+	Required    bool   `xml:"isRequired"`
+	Description string `xml:"description"`
+}
+
+//ParseParameterDefinitions parses the parameters.propdef file
+func ParseParameterDefinitions(content []byte) ([]ParameterDefinition, error) {
+	var d struct {
+		Parameters []ParameterDefinition `xml:"parameter"`
+	}
+	if err := xml.Unmarshal(content, &d); err != nil {
+		return nil, fmt.Errorf("%w: parameters.propdef: %s", ErrInvalid, err)
+	}
+	return d.Parameters, nil
+}
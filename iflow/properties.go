@@ -0,0 +1,218 @@
+package iflow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//Properties - Java properties file, e.g. parameters.prop. Comments and the order of the entries are kept,
+//only changed entries are written in the escaped form of java.util.Properties.
+type Properties struct {
+	lines   []propertyLine
+	newline string
+}
+
+type propertyLine struct {
+	raw   string
+	entry bool
+	key   string
+	value string
+}
+
+//ParseProperties parses the properties file
+func ParseProperties(content []byte) *Properties {
+	p := &Properties{newline: "\n"}
+	text := string(content)
+	if strings.Contains(text, "\r\n") {
+		p.newline = "\r\n"
+	}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return p
+	}
+
+	physical := strings.Split(text, "\n")
+	for i := 0; i < len(physical); i++ {
+		raw := physical[i]
+		logical := strings.TrimLeft(raw, " \t\f")
+		if logical == "" || logical[0] == '#' || logical[0] == '!' {
+			p.lines = append(p.lines, propertyLine{raw: raw})
+			continue
+		}
+		// the line ending with odd number of backslashes continues on the next line
+		for continuesOnNextLine(logical) && i+1 < len(physical) {
+			i++
+			raw += "\n" + physical[i]
+			logical = logical[:len(logical)-1] + strings.TrimLeft(physical[i], " \t\f")
+		}
+		key, value := splitProperty(logical)
+		p.lines = append(p.lines, propertyLine{raw: raw, entry: true, key: key, value: value})
+	}
+	return p
+}
+
+func continuesOnNextLine(line string) bool {
+	n := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+//splitProperty splits the line on the first unescaped separator and unescapes key and value
+func splitProperty(line string) (string, string) {
+	end := len(line)
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' {
+			i++
+			continue
+		}
+		if line[i] == '=' || line[i] == ':' || line[i] == ' ' || line[i] == '\t' || line[i] == '\f' {
+			end = i
+			break
+		}
+	}
+	key := line[:end]
+	rest := strings.TrimLeft(line[end:], " \t\f")
+	if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], " \t\f")
+	}
+	return unescapeProperty(key), unescapeProperty(rest)
+}
+
+func unescapeProperty(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 == len(s) {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if i+4 < len(s) {
+				if r, err := strconv.ParseUint(s[i+1:i+5], 16, 32); err == nil {
+					b.WriteRune(rune(r))
+					i += 4
+					continue
+				}
+			}
+			b.WriteByte('u')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+//escapeProperty escapes the key or the value the same way as java.util.Properties.store
+func escapeProperty(s string, isKey bool) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch r {
+		case ' ':
+			if i == 0 || isKey {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(' ')
+		case '\t':
+			b.WriteString(`\t`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '=', ':', '#', '!', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		default:
+			if r < 0x20 || r > 0x7e {
+				if r > 0xffff {
+					for _, u := range utf16Surrogates(r) {
+						fmt.Fprintf(&b, `\u%04X`, u)
+					}
+					continue
+				}
+				fmt.Fprintf(&b, `\u%04X`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func utf16Surrogates(r rune) [2]rune {
+	r -= 0x10000
+	return [2]rune{0xd800 + (r>>10)&0x3ff, 0xdc00 + r&0x3ff}
+}
+
+//Keys returns the keys in the order of the file
+func (p *Properties) Keys() []string {
+	var keys []string
+	for _, l := range p.lines {
+		if l.entry {
+			keys = append(keys, l.key)
+		}
+	}
+	return keys
+}
+
+//Get returns the unescaped value and true when the key exists
+func (p *Properties) Get(key string) (string, bool) {
+	for _, l := range p.lines {
+		if l.entry && l.key == key {
+			return l.value, true
+		}
+	}
+	return "", false
+}
+
+//Set sets the value of the key, the entry is appended when missing
+func (p *Properties) Set(key string, value string) {
+	raw := escapeProperty(key, true) + "=" + escapeProperty(value, false)
+	for i, l := range p.lines {
+		if l.entry && l.key == key {
+			if l.value != value {
+				p.lines[i] = propertyLine{raw: raw, entry: true, key: key, value: value}
+			}
+			return
+		}
+	}
+	p.lines = append(p.lines, propertyLine{raw: raw, entry: true, key: key, value: value})
+}
+
+//Delete removes the key
+func (p *Properties) Delete(key string) {
+	for i, l := range p.lines {
+		if l.entry && l.key == key {
+			p.lines = append(p.lines[:i], p.lines[i+1:]...)
+			return
+		}
+	}
+}
+
+//Bytes returns the properties file
+func (p *Properties) Bytes() []byte {
+	var b strings.Builder
+	for _, l := range p.lines {
+		b.WriteString(strings.ReplaceAll(l.raw, "\n", p.newline))
+		b.WriteString(p.newline)
+	}
+	return []byte(b.String())
+}
@@ -0,0 +1,160 @@
+//Package lint checks the integration flow against the configurable rule set,
+//e.g. hard-coded addresses of the channels or Groovy scripts logging the payload.
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/iflow"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	//ErrInvalid - the configuration of the rule set is not valid
+	ErrInvalid = errors.New("invalid lint configuration")
+)
+
+//Severity - severity of the finding
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+//Severities - all severities from the highest
+var Severities = []Severity{SeverityError, SeverityWarning, SeverityInfo}
+
+func (s Severity) rank() int {
+	for i, v := range Severities {
+		if v == s {
+			return len(Severities) - i
+		}
+	}
+	return 0
+}
+
+//ParseSeverity returns the severity by name
+func ParseSeverity(name string) (Severity, error) {
+	s := Severity(strings.ToLower(name))
+	if s.rank() == 0 {
+		return "", fmt.Errorf("%w: unknown severity %q, available values: error, warning, info", ErrInvalid, name)
+	}
+	return s, nil
+}
+
+//Finding - violation of the rule, the line is 1-based and 0 when unknown
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	File     string   `json:"file,omitempty"`
+	Line     int      `json:"line,omitempty"`
+	Element  string   `json:"element,omitempty"`
+}
+
+//Config - configuration of the rule set, the rules which are not listed are enabled with the default settings
+type Config struct {
+	Rules map[string]RuleConfig `json:"rules" yaml:"rules"`
+}
+
+//RuleConfig - settings of the rule. Pattern and Properties are used only by the rules which describe them.
+type RuleConfig struct {
+	Enabled    *bool    `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Severity   Severity `json:"severity,omitempty" yaml:"severity,omitempty"`
+	Pattern    string   `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Properties []string `json:"properties,omitempty" yaml:"properties,omitempty"`
+
+	//hardcodedURLChecked is set when the rule hardcoded-url is enabled, the addresses reported by it are skipped by externalize-address
+	hardcodedURLChecked bool
+}
+
+//ReadConfig reads the configuration of the rule set in yaml or json format
+func ReadConfig(fileName string) (*Config, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var c Config
+	if err := yaml.Unmarshal(content, &c); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	}
+	return &c, nil
+}
+
+//Lint checks the flow with the rules enabled in the configuration, the configuration may be nil.
+//The findings are sorted by the file and the line.
+func Lint(flow *iflow.Flow, config *Config) ([]Finding, error) {
+	if config == nil {
+		config = &Config{}
+	}
+	for id := range config.Rules {
+		if FindRule(id) == nil {
+			return nil, fmt.Errorf("%w: unknown rule %q", ErrInvalid, id)
+		}
+	}
+
+	var findings []Finding
+	for _, rule := range Rules {
+		if !ruleEnabled(config, rule.ID) {
+			continue
+		}
+		settings := RuleConfig{Severity: rule.Severity, Pattern: rule.Pattern, Properties: rule.Properties}
+		settings.hardcodedURLChecked = ruleEnabled(config, "hardcoded-url")
+		if c, ok := config.Rules[rule.ID]; ok {
+			if c.Severity != "" {
+				s, err := ParseSeverity(string(c.Severity))
+				if err != nil {
+					return nil, fmt.Errorf("rule %s: %w", rule.ID, err)
+				}
+				settings.Severity = s
+			}
+			if c.Pattern != "" {
+				settings.Pattern = c.Pattern
+			}
+			if len(c.Properties) > 0 {
+				settings.Properties = c.Properties
+			}
+		}
+
+		ruleFindings, err := rule.Check(flow, settings)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", rule.ID, err)
+		}
+		for _, f := range ruleFindings {
+			f.Rule = rule.ID
+			f.Severity = settings.Severity
+			findings = append(findings, f)
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].File != findings[j].File {
+			return findings[i].File < findings[j].File
+		}
+		return findings[i].Line < findings[j].Line
+	})
+	return findings, nil
+}
+
+//ruleEnabled reports whether the rule is not disabled in the configuration
+func ruleEnabled(config *Config, id string) bool {
+	c, ok := config.Rules[id]
+	return !ok || c.Enabled == nil || *c.Enabled
+}
+
+//AtLeast returns the findings with the severity or higher
+func AtLeast(findings []Finding, severity Severity) []Finding {
+	var result []Finding
+	for _, f := range findings {
+		if f.Severity.rank() >= severity.rank() {
+			result = append(result, f)
+		}
+	}
+	return result
+}
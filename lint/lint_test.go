@@ -0,0 +1,226 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/iflow"
+	"github.com/tobiaszgithub/cig/lint"
+)
+
+const testModel = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn2:definitions xmlns:bpmn2="http://www.omg.org/spec/BPMN/20100524/MODEL" xmlns:ifl="http:///com.sap.ifl.model/Ifl.xsd" id="Definitions_1">
+	<bpmn2:collaboration id="Collaboration_1" name="Default Collaboration">
+		<bpmn2:messageFlow id="MessageFlow_1" name="HTTPS" sourceRef="Participant_1" targetRef="StartEvent_2">
+			<bpmn2:extensionElements>
+				<ifl:property><key>ComponentType</key><value>HTTPS</value></ifl:property>
+				<ifl:property><key>direction</key><value>Sender</value></ifl:property>
+				<ifl:property><key>urlPath</key><value>/orders</value></ifl:property>
+			</bpmn2:extensionElements>
+		</bpmn2:messageFlow>
+		<bpmn2:messageFlow id="MessageFlow_2" name="SOAP" sourceRef="EndEvent_2" targetRef="Participant_2">
+			<bpmn2:extensionElements>
+				<ifl:property><key>ComponentType</key><value>SOAP</value></ifl:property>
+				<ifl:property><key>direction</key><value>Receiver</value></ifl:property>
+				<ifl:property><key>address</key><value>https://partner.example.com/orders</value></ifl:property>
+			</bpmn2:extensionElements>
+		</bpmn2:messageFlow>
+		<bpmn2:messageFlow id="MessageFlow_3" name="SFTP" sourceRef="EndEvent_2" targetRef="Participant_3">
+			<bpmn2:extensionElements>
+				<ifl:property><key>ComponentType</key><value>SFTP</value></ifl:property>
+				<ifl:property><key>direction</key><value>Receiver</value></ifl:property>
+				<ifl:property><key>host</key><value>{{SFTP_Host}}</value></ifl:property>
+			</bpmn2:extensionElements>
+		</bpmn2:messageFlow>
+	</bpmn2:collaboration>
+</bpmn2:definitions>
+`
+
+const testScript = `import com.sap.gateway.ip.core.customdev.util.Message
+
+def Message processData(Message message) {
+	def body = message.getBody(String)
+	// messageLog.addAttachmentAsString("Payload", body, "text/plain")
+	def messageLog = messageLogFactory.getMessageLog(message)
+	messageLog.addAttachmentAsString("Payload", body, "text/plain")
+	messageLog.setStringProperty("Status", "done")
+	return message
+}
+`
+
+const modelPath = "src/main/resources/scenarioflows/integrationflow/orders.iflw"
+
+func testFlow(t *testing.T) *iflow.Flow {
+	t.Helper()
+	flow, err := iflow.New([]*iflow.File{
+		{Name: iflow.ManifestPath, Content: []byte("Manifest-Version: 1.0\r\nBundle-SymbolicName: replicate-orders; singleton:=true\r\nBundle-Name: Orders\r\n\r\n")},
+		{Name: modelPath, Content: []byte(testModel)},
+		{Name: "src/main/resources/script/log.groovy", Content: []byte(testScript)},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	return flow
+}
+
+func TestLint(t *testing.T) {
+	disabled := false
+	testCases := []struct {
+		name     string
+		config   *lint.Config
+		expError error
+		exp      []lint.Finding
+	}{
+		{
+			name: "defaultRules",
+			exp: []lint.Finding{
+				{Rule: "flow-id-naming", Severity: lint.SeverityWarning, File: iflow.ManifestPath, Line: 2,
+					Message: "flow id replicate-orders does not match the pattern ^[A-Z][A-Za-z0-9]*(_[A-Za-z0-9]+)*$"},
+				{Rule: "hardcoded-url", Severity: lint.SeverityError, File: modelPath, Line: 11, Element: "MessageFlow_2",
+					Message: "channel SOAP (SOAP): property address contains hard-coded URL https://partner.example.com/orders"},
+				{Rule: "undefined-parameter", Severity: lint.SeverityError, File: modelPath, Line: 22,
+					Message: "parameter SFTP_Host is referenced but not defined in src/main/resources/parameters.propdef"},
+				{Rule: "script-log-payload", Severity: lint.SeverityError, File: "src/main/resources/script/log.groovy", Line: 7,
+					Message: `script logs the payload: messageLog.addAttachmentAsString("Payload", body, "text/plain")`},
+			},
+		},
+		{
+			name: "configuredRules",
+			config: &lint.Config{Rules: map[string]lint.RuleConfig{
+				"hardcoded-url":       {Enabled: &disabled},
+				"script-log-payload":  {Enabled: &disabled},
+				"externalize-address": {Severity: "error", Properties: []string{"host"}},
+				"flow-id-naming":      {Pattern: `^[a-z-]+$`},
+			}},
+			exp: []lint.Finding{
+				{Rule: "undefined-parameter", Severity: lint.SeverityError, File: modelPath, Line: 22,
+					Message: "parameter SFTP_Host is referenced but not defined in src/main/resources/parameters.propdef"},
+			},
+		},
+		{
+			name: "hardcodedURLDisabled",
+			config: &lint.Config{Rules: map[string]lint.RuleConfig{
+				"hardcoded-url":      {Enabled: &disabled},
+				"script-log-payload": {Enabled: &disabled},
+				"flow-id-naming":     {Enabled: &disabled},
+			}},
+			exp: []lint.Finding{
+				{Rule: "externalize-address", Severity: lint.SeverityWarning, File: modelPath, Line: 11, Element: "MessageFlow_2",
+					Message: "receiver channel SOAP (SOAP): property address is not externalized: https://partner.example.com/orders"},
+				{Rule: "undefined-parameter", Severity: lint.SeverityError, File: modelPath, Line: 22,
+					Message: "parameter SFTP_Host is referenced but not defined in src/main/resources/parameters.propdef"},
+			},
+		},
+		{
+			name:     "unknownRule",
+			config:   &lint.Config{Rules: map[string]lint.RuleConfig{"no-such-rule": {}}},
+			expError: lint.ErrInvalid,
+		},
+		{
+			name:     "invalidSeverity",
+			config:   &lint.Config{Rules: map[string]lint.RuleConfig{"hardcoded-url": {Severity: "fatal"}}},
+			expError: lint.ErrInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			findings, err := lint.Lint(testFlow(t), tc.config)
+			if tc.expError != nil {
+				if !errors.Is(err, tc.expError) {
+					t.Errorf("Expected error %q, got %q.", tc.expError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %q.", err)
+			}
+			if !reflect.DeepEqual(findings, tc.exp) {
+				t.Errorf("Expected findings:\n%+v\ngot:\n%+v", tc.exp, findings)
+			}
+		})
+	}
+}
+
+func TestAtLeast(t *testing.T) {
+	findings, _ := lint.Lint(testFlow(t), nil)
+	if n := len(lint.AtLeast(findings, lint.SeverityError)); n != 3 {
+		t.Errorf("Expected 3 errors, got %d", n)
+	}
+	if n := len(lint.AtLeast(findings, lint.SeverityInfo)); n != 4 {
+		t.Errorf("Expected 4 findings, got %d", n)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	findings, _ := lint.Lint(testFlow(t), nil)
+
+	var out bytes.Buffer
+	if err := lint.Write(&out, "replicate-orders", findings, "text"); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if !strings.Contains(out.String(), "src/main/resources/script/log.groovy:7: error: script logs the payload") ||
+		!strings.Contains(out.String(), "findings: 4 (errors: 3, warnings: 1, info: 0)") {
+		t.Errorf("Unexpected text output:\n%s", out.String())
+	}
+
+	out.Reset()
+	if err := lint.Write(&out, "replicate-orders", findings, "json"); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	var doc struct {
+		Flow     string
+		Findings []lint.Finding
+	}
+	if err := json.Unmarshal(out.Bytes(), &doc); err != nil || doc.Flow != "replicate-orders" || !reflect.DeepEqual(doc.Findings, findings) {
+		t.Errorf("Unexpected json output %q: %v", out.String(), err)
+	}
+
+	out.Reset()
+	if err := lint.Write(&out, "replicate-orders", findings, "sarif"); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	var sarif struct {
+		Version string
+		Runs    []struct {
+			Results []struct {
+				RuleID    string
+				Level     string
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct{ URI string }
+						Region           struct{ StartLine int }
+					}
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(out.Bytes(), &sarif); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	results := sarif.Runs[0].Results
+	if sarif.Version != "2.1.0" || len(results) != 4 || results[3].Level != "error" ||
+		results[3].Locations[0].PhysicalLocation.ArtifactLocation.URI != "src/main/resources/script/log.groovy" ||
+		results[3].Locations[0].PhysicalLocation.Region.StartLine != 7 {
+		t.Errorf("Unexpected sarif output:\n%s", out.String())
+	}
+
+	out.Reset()
+	if err := lint.WriteSource(&out, "replicate-orders", "flows/replicate-orders", findings, "sarif"); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if err := json.Unmarshal(out.Bytes(), &sarif); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	if uri := sarif.Runs[0].Results[3].Locations[0].PhysicalLocation.ArtifactLocation.URI; uri != "flows/replicate-orders/src/main/resources/script/log.groovy" {
+		t.Errorf("Unexpected artifact location %s", uri)
+	}
+
+	if err := lint.Write(&out, "replicate-orders", findings, "xml"); !errors.Is(err, lint.ErrInvalid) {
+		t.Errorf("Expected error %q, got %q.", lint.ErrInvalid, err)
+	}
+}
@@ -0,0 +1,150 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+//Formats - output formats of the findings
+var Formats = []string{"text", "json", "sarif"}
+
+//Write writes the findings of the flow in the format text, json or sarif
+func Write(out io.Writer, flowID string, findings []Finding, format string) error {
+	return WriteSource(out, flowID, "", findings, format)
+}
+
+//WriteSource writes the findings like Write, the sarif artifact locations are prefixed with sourceDir, the slash separated
+//directory of the flow relative to the root of the repository, so that code scanning tools find the files
+func WriteSource(out io.Writer, flowID string, sourceDir string, findings []Finding, format string) error {
+	switch strings.ToLower(format) {
+	case "", "text":
+		writeText(out, flowID, findings)
+		return nil
+	case "json":
+		return writeJSON(out, flowID, findings)
+	case "sarif":
+		return writeSARIF(out, sourceDir, findings)
+	}
+	return fmt.Errorf("%w: unknown format %q, available values: %s", ErrInvalid, format, strings.Join(Formats, ", "))
+}
+
+func writeText(out io.Writer, flowID string, findings []Finding) {
+	counts := map[Severity]int{}
+	for _, f := range findings {
+		location := f.File
+		if f.Line > 0 {
+			location += fmt.Sprintf(":%d", f.Line)
+		}
+		fmt.Fprintf(out, "%s: %s: %s [%s]\n", location, f.Severity, f.Message, f.Rule)
+		counts[f.Severity]++
+	}
+	fmt.Fprintf(out, "Integration flow: %s, findings: %d (errors: %d, warnings: %d, info: %d)\n",
+		flowID, len(findings), counts[SeverityError], counts[SeverityWarning], counts[SeverityInfo])
+}
+
+func writeJSON(out io.Writer, flowID string, findings []Finding) error {
+	if findings == nil {
+		findings = []Finding{}
+	}
+	b, err := json.MarshalIndent(struct {
+		Flow     string    `json:"flow"`
+		Findings []Finding `json:"findings"`
+	}{flowID, findings}, "", "\t")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, string(b))
+	return nil
+}
+
+//SARIF 2.1.0, only the properties used by the code scanning tools are written
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool struct {
+		Driver struct {
+			Name  string      `json:"name"`
+			Rules []sarifRule `json:"rules"`
+		} `json:"driver"`
+	} `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	DefaultConfig    struct {
+		Level string `json:"level"`
+	} `json:"defaultConfiguration"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+		Region *sarifRegion `json:"region,omitempty"`
+	} `json:"physicalLocation"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+func sarifLevel(s Severity) string {
+	if s == SeverityInfo {
+		return "note"
+	}
+	return string(s)
+}
+
+func writeSARIF(out io.Writer, sourceDir string, findings []Finding) error {
+	run := sarifRun{Results: []sarifResult{}}
+	run.Tool.Driver.Name = "cig"
+	for _, r := range Rules {
+		rule := sarifRule{ID: r.ID, ShortDescription: sarifMessage{r.Description}}
+		rule.DefaultConfig.Level = sarifLevel(r.Severity)
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+	}
+	for _, f := range findings {
+		result := sarifResult{RuleID: f.Rule, Level: sarifLevel(f.Severity), Message: sarifMessage{f.Message}}
+		if f.File != "" {
+			var location sarifLocation
+			location.PhysicalLocation.ArtifactLocation.URI = path.Join(sourceDir, f.File)
+			if f.Line > 0 {
+				location.PhysicalLocation.Region = &sarifRegion{StartLine: f.Line}
+			}
+			result.Locations = append(result.Locations, location)
+		}
+		run.Results = append(run.Results, result)
+	}
+
+	b, err := json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, string(b))
+	return nil
+}
@@ -0,0 +1,227 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/iflow"
+)
+
+const (
+	unusedParameterRule    = "unused-parameter"
+	undefinedParameterRule = "undefined-parameter"
+	parameterTypeRule      = "parameter-type"
+)
+
+//Parameter - configuration parameter of the flow in the tenant
+type Parameter struct {
+	Key   string
+	Value string
+	Type  string
+}
+
+var (
+	parameterPlaceholder = regexp.MustCompile(`\{\{([^{}]+)\}\}`)
+
+	parameterTypePatterns = map[string]*regexp.Regexp{
+		"xsd:integer": regexp.MustCompile(`^[-+]?[0-9]+$`),
+		"xsd:int":     regexp.MustCompile(`^[-+]?[0-9]+$`),
+		"xsd:long":    regexp.MustCompile(`^[-+]?[0-9]+$`),
+		"xsd:decimal": regexp.MustCompile(`^[-+]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`),
+		"xsd:double":  regexp.MustCompile(`^[-+]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][-+]?[0-9]+)?$`),
+		"xsd:float":   regexp.MustCompile(`^[-+]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][-+]?[0-9]+)?$`),
+		"xsd:boolean": regexp.MustCompile(`^(true|false)$`),
+	}
+)
+
+//placeholderReference - the first reference of the parameter in the model files
+type placeholderReference struct {
+	file string
+	line int
+}
+
+//parameterReferences returns the parameters referenced as {{name}} in the .iflw files
+func parameterReferences(flow *iflow.Flow) map[string]placeholderReference {
+	refs := map[string]placeholderReference{}
+	for _, f := range flow.Files {
+		if !strings.HasPrefix(f.Name, iflow.ModelDir) || !strings.HasSuffix(f.Name, ".iflw") {
+			continue
+		}
+		for i, line := range strings.Split(string(f.Content), "\n") {
+			for _, m := range parameterPlaceholder.FindAllStringSubmatch(line, -1) {
+				key := strings.TrimSpace(m[1])
+				if _, ok := refs[key]; !ok {
+					refs[key] = placeholderReference{file: f.Name, line: i + 1}
+				}
+			}
+		}
+	}
+	return refs
+}
+
+//definedParameters returns the parameters of parameters.propdef and parameters.prop with the type of the definition
+func definedParameters(flow *iflow.Flow) map[string]string {
+	defined := map[string]string{}
+	for _, d := range flow.ParameterDefinitions {
+		defined[d.Key] = d.Type
+	}
+	if flow.Parameters != nil {
+		for _, k := range flow.Parameters.Keys() {
+			if _, ok := defined[k]; !ok {
+				defined[k] = ""
+			}
+		}
+	}
+	return defined
+}
+
+//propertyLineOf returns the 1-based line of the property with the key or 0, the key has to start the line
+//so that the key is not matched inside of another key
+func propertyLineOf(content []byte, key string) int {
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimLeft(line, " \t\f")
+		if !strings.HasPrefix(line, key) {
+			continue
+		}
+		if rest := line[len(key):]; rest == "" || strings.ContainsAny(rest[:1], "=: \t\f\r") {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+//validParameterValue reports whether the value matches the xsd type, the other types are not checked
+func validParameterValue(parameterType string, value string) bool {
+	if value == "" || strings.Contains(value, "{{") {
+		return true
+	}
+	pattern, ok := parameterTypePatterns[parameterType]
+	return !ok || pattern.MatchString(value)
+}
+
+func checkUnusedParameters(flow *iflow.Flow, settings RuleConfig) ([]Finding, error) {
+	return parameterFindings(flow, nil, unusedParameterRule), nil
+}
+
+func checkUndefinedParameters(flow *iflow.Flow, settings RuleConfig) ([]Finding, error) {
+	return parameterFindings(flow, nil, undefinedParameterRule), nil
+}
+
+func checkParameterTypes(flow *iflow.Flow, settings RuleConfig) ([]Finding, error) {
+	return parameterFindings(flow, nil, parameterTypeRule), nil
+}
+
+//CheckParameters cross-references the placeholders of the .iflw files, parameters.prop, parameters.propdef
+//and the configuration parameters of the flow in the tenant. The tenant is not checked when tenant is nil.
+func CheckParameters(flow *iflow.Flow, tenant []Parameter) []Finding {
+	var findings []Finding
+	for _, rule := range []string{undefinedParameterRule, unusedParameterRule, parameterTypeRule} {
+		for _, f := range parameterFindings(flow, tenant, rule) {
+			f.Rule = rule
+			f.Severity = FindRule(rule).Severity
+			findings = append(findings, f)
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].File != findings[j].File {
+			return findings[i].File < findings[j].File
+		}
+		return findings[i].Line < findings[j].Line
+	})
+	return findings
+}
+
+func parameterFindings(flow *iflow.Flow, tenant []Parameter, rule string) []Finding {
+	refs := parameterReferences(flow)
+	defined := definedParameters(flow)
+
+	propdefLine := func(key string) Finding {
+		f := Finding{File: iflow.ParameterDefinitionsPath}
+		if c := flow.File(iflow.ParameterDefinitionsPath); c != nil {
+			f.Line = lineOf(c.Content, "<key>"+key+"</key>")
+		} else {
+			f.File = iflow.ParametersPath
+		}
+		return f
+	}
+	tenantFinding := func(message string) Finding {
+		return Finding{File: iflow.ManifestPath, Message: message, Element: flow.ID()}
+	}
+
+	var findings []Finding
+	switch rule {
+	case undefinedParameterRule:
+		configured := map[string]bool{}
+		for _, p := range tenant {
+			configured[p.Key] = true
+		}
+		for _, key := range sortedKeys(refs) {
+			var missing []string
+			if _, ok := defined[key]; !ok {
+				missing = append(missing, "not defined in "+iflow.ParameterDefinitionsPath)
+			}
+			if tenant != nil && !configured[key] {
+				missing = append(missing, "not configured in the tenant")
+			}
+			if len(missing) > 0 {
+				findings = append(findings, Finding{File: refs[key].file, Line: refs[key].line,
+					Message: fmt.Sprintf("parameter %s is referenced but %s", key, strings.Join(missing, " and "))})
+			}
+		}
+
+	case unusedParameterRule:
+		for _, key := range sortedKeys(defined) {
+			if _, ok := refs[key]; !ok {
+				f := propdefLine(key)
+				f.Message = fmt.Sprintf("parameter %s is defined but not referenced in the integration flow", key)
+				findings = append(findings, f)
+			}
+		}
+		for _, p := range tenant {
+			_, referenced := refs[p.Key]
+			if _, ok := defined[p.Key]; !ok && !referenced {
+				findings = append(findings, tenantFinding(fmt.Sprintf("parameter %s is configured in the tenant but not referenced in the integration flow", p.Key)))
+			}
+		}
+
+	case parameterTypeRule:
+		for _, key := range sortedKeys(defined) {
+			if flow.Parameters == nil {
+				break
+			}
+			value, ok := flow.Parameters.Get(key)
+			if ok && !validParameterValue(defined[key], value) {
+				f := Finding{File: iflow.ParametersPath, Line: propertyLineOf(flow.File(iflow.ParametersPath).Content, key)}
+				f.Message = fmt.Sprintf("value %s of parameter %s is not valid %s", value, key, defined[key])
+				findings = append(findings, f)
+			}
+		}
+		for _, p := range tenant {
+			definedType := defined[p.Key]
+			if definedType != "" && p.Type != "" && definedType != p.Type {
+				f := propdefLine(p.Key)
+				f.Message = fmt.Sprintf("parameter %s is defined as %s but configured in the tenant as %s", p.Key, definedType, p.Type)
+				findings = append(findings, f)
+			}
+			parameterType := p.Type
+			if parameterType == "" {
+				parameterType = definedType
+			}
+			if !validParameterValue(parameterType, p.Value) {
+				findings = append(findings, tenantFinding(fmt.Sprintf("value %s of parameter %s configured in the tenant is not valid %s", p.Value, p.Key, parameterType)))
+			}
+		}
+	}
+	return findings
+}
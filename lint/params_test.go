@@ -0,0 +1,145 @@
+package lint_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/iflow"
+	"github.com/tobiaszgithub/cig/lint"
+)
+
+const testParamsModel = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn2:definitions xmlns:bpmn2="http://www.omg.org/spec/BPMN/20100524/MODEL" xmlns:ifl="http:///com.sap.ifl.model/Ifl.xsd" id="Definitions_1">
+	<bpmn2:collaboration id="Collaboration_1" name="Default Collaboration">
+		<bpmn2:messageFlow id="MessageFlow_1" name="SFTP" sourceRef="EndEvent_2" targetRef="Participant_2">
+			<bpmn2:extensionElements>
+				<ifl:property><key>host</key><value>{{SFTP_Host}}</value></ifl:property>
+				<ifl:property><key>port</key><value>{{SFTP_Port}}</value></ifl:property>
+				<ifl:property><key>directory</key><value>{{Directory}}</value></ifl:property>
+			</bpmn2:extensionElements>
+		</bpmn2:messageFlow>
+	</bpmn2:collaboration>
+</bpmn2:definitions>
+`
+
+const testParamsDefinitions = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<parameters>
+	<parameter><key>SFTP_Host</key><name>SFTP_Host</name><type>xsd:string</type><isRequired>true</isRequired></parameter>
+	<parameter><key>SFTP_Port</key><name>SFTP_Port</name><type>xsd:integer</type><isRequired>true</isRequired></parameter>
+	<parameter><key>Greeting</key><name>Greeting</name><type>xsd:string</type><isRequired>false</isRequired></parameter>
+</parameters>
+`
+
+const testParamsModelPath = "src/main/resources/scenarioflows/integrationflow/Orders.iflw"
+
+func testParamsFlow(t *testing.T) *iflow.Flow {
+	t.Helper()
+	flow, err := iflow.New([]*iflow.File{
+		{Name: iflow.ManifestPath, Content: []byte("Manifest-Version: 1.0\r\nBundle-SymbolicName: Orders\r\n\r\n")},
+		{Name: testParamsModelPath, Content: []byte(testParamsModel)},
+		{Name: iflow.ParametersPath, Content: []byte("SFTP_Host=sftp.example.com\nSFTP_Port=22a\nGreeting=Hello\n")},
+		{Name: iflow.ParameterDefinitionsPath, Content: []byte(testParamsDefinitions)},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	return flow
+}
+
+func TestCheckParametersWithoutDefinitions(t *testing.T) {
+	flow, err := iflow.New([]*iflow.File{
+		{Name: iflow.ManifestPath, Content: []byte("Manifest-Version: 1.0\r\nBundle-SymbolicName: Orders\r\n\r\n")},
+		{Name: testParamsModelPath, Content: []byte(testParamsModel)},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	exp := []lint.Finding{
+		{Rule: "undefined-parameter", Severity: lint.SeverityError, File: testParamsModelPath, Line: 6,
+			Message: "parameter SFTP_Host is referenced but not defined in src/main/resources/parameters.propdef"},
+		{Rule: "undefined-parameter", Severity: lint.SeverityError, File: testParamsModelPath, Line: 7,
+			Message: "parameter SFTP_Port is referenced but not defined in src/main/resources/parameters.propdef"},
+		{Rule: "undefined-parameter", Severity: lint.SeverityError, File: testParamsModelPath, Line: 8,
+			Message: "parameter Directory is referenced but not defined in src/main/resources/parameters.propdef"},
+	}
+	findings := lint.CheckParameters(flow, nil)
+	if !reflect.DeepEqual(findings, exp) {
+		t.Errorf("Expected findings:\n%+v\ngot:\n%+v", exp, findings)
+	}
+}
+
+func TestCheckParameters(t *testing.T) {
+	local := []lint.Finding{
+		{Rule: "parameter-type", Severity: lint.SeverityError, File: iflow.ParametersPath, Line: 2,
+			Message: "value 22a of parameter SFTP_Port is not valid xsd:integer"},
+		{Rule: "unused-parameter", Severity: lint.SeverityWarning, File: iflow.ParameterDefinitionsPath, Line: 5,
+			Message: "parameter Greeting is defined but not referenced in the integration flow"},
+	}
+	testCases := []struct {
+		name   string
+		tenant []lint.Parameter
+		exp    []lint.Finding
+	}{
+		{
+			name: "local",
+			exp: []lint.Finding{
+				local[0], local[1],
+				{Rule: "undefined-parameter", Severity: lint.SeverityError, File: testParamsModelPath, Line: 8,
+					Message: "parameter Directory is referenced but not defined in src/main/resources/parameters.propdef"},
+			},
+		},
+		{
+			name: "tenant",
+			tenant: []lint.Parameter{
+				{Key: "SFTP_Host", Value: "sftp.example.com", Type: "xsd:string"},
+				{Key: "SFTP_Port", Value: "port", Type: "xsd:string"},
+				{Key: "Timeout", Value: "30", Type: "xsd:integer"},
+			},
+			exp: []lint.Finding{
+				{Rule: "unused-parameter", Severity: lint.SeverityWarning, File: iflow.ManifestPath, Element: "Orders",
+					Message: "parameter Timeout is configured in the tenant but not referenced in the integration flow"},
+				local[0],
+				{Rule: "parameter-type", Severity: lint.SeverityError, File: iflow.ParameterDefinitionsPath, Line: 4,
+					Message: "parameter SFTP_Port is defined as xsd:integer but configured in the tenant as xsd:string"},
+				local[1],
+				{Rule: "undefined-parameter", Severity: lint.SeverityError, File: testParamsModelPath, Line: 8,
+					Message: "parameter Directory is referenced but not defined in src/main/resources/parameters.propdef and not configured in the tenant"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			findings := lint.CheckParameters(testParamsFlow(t), tc.tenant)
+			if !reflect.DeepEqual(findings, tc.exp) {
+				t.Errorf("Expected findings:\n%+v\ngot:\n%+v", tc.exp, findings)
+			}
+		})
+	}
+}
+
+func TestCheckParameterTypesLine(t *testing.T) {
+	flow, err := iflow.New([]*iflow.File{
+		{Name: iflow.ManifestPath, Content: []byte("Manifest-Version: 1.0\r\nBundle-SymbolicName: Orders\r\n\r\n")},
+		{Name: testParamsModelPath, Content: []byte(`<ifl:property><key>timeout</key><value>{{ReadTimeout}}</value></ifl:property>
+<ifl:property><key>connectTimeout</key><value>{{Timeout}}</value></ifl:property>
+`)},
+		{Name: iflow.ParametersPath, Content: []byte("ReadTimeout=60\nTimeout=30s\n")},
+		{Name: iflow.ParameterDefinitionsPath, Content: []byte(`<parameters>
+	<parameter><key>ReadTimeout</key><type>xsd:integer</type></parameter>
+	<parameter><key>Timeout</key><type>xsd:integer</type></parameter>
+</parameters>
+`)},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	exp := []lint.Finding{
+		{Rule: "parameter-type", Severity: lint.SeverityError, File: iflow.ParametersPath, Line: 2,
+			Message: "value 30s of parameter Timeout is not valid xsd:integer"},
+	}
+	findings := lint.CheckParameters(flow, nil)
+	if !reflect.DeepEqual(findings, exp) {
+		t.Errorf("Expected findings:\n%+v\ngot:\n%+v", exp, findings)
+	}
+}
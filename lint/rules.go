@@ -0,0 +1,202 @@
+package lint
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/tobiaszgithub/cig/iflow"
+)
+
+//Rule - check of the flow, Pattern and Properties are the default settings of the rule
+type Rule struct {
+	ID          string
+	Description string
+	Severity    Severity
+	Pattern     string
+	Properties  []string
+	Check       func(flow *iflow.Flow, settings RuleConfig) ([]Finding, error)
+}
+
+var (
+	//Rules - all rules in the order of the check
+	Rules = []Rule{
+		{
+			ID:          "hardcoded-url",
+			Description: "Channels must not contain hard-coded URLs, the URL has to be externalized as {{parameter}}",
+			Severity:    SeverityError,
+			Check:       checkHardcodedURL,
+		},
+		{
+			ID:          "externalize-address",
+			Description: "Addresses of the receiver channels must be externalized as {{parameter}}",
+			Severity:    SeverityWarning,
+			Properties:  []string{"address", "host", "httpAddressWithoutQuery", "url"},
+			Check:       checkExternalizedAddress,
+		},
+		{
+			ID:          "script-log-payload",
+			Description: "Groovy scripts must not log the payload",
+			Severity:    SeverityError,
+			Check:       checkScriptLogPayload,
+		},
+		{
+			ID:          "flow-id-naming",
+			Description: "Id of the integration flow must match the naming convention",
+			Severity:    SeverityWarning,
+			Pattern:     `^[A-Z][A-Za-z0-9]*(_[A-Za-z0-9]+)*$`,
+			Check:       checkFlowIDNaming,
+		},
+		{
+			ID:          undefinedParameterRule,
+			Description: "Parameters referenced as {{parameter}} must be defined in parameters.propdef",
+			Severity:    SeverityError,
+			Check:       checkUndefinedParameters,
+		},
+		{
+			ID:          unusedParameterRule,
+			Description: "Parameters of parameters.propdef must be referenced in the integration flow",
+			Severity:    SeverityWarning,
+			Check:       checkUnusedParameters,
+		},
+		{
+			ID:          parameterTypeRule,
+			Description: "Values of the parameters must match the type of the definition",
+			Severity:    SeverityError,
+			Check:       checkParameterTypes,
+		},
+	}
+
+	urlPattern         = regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://`)
+	placeholderPattern = regexp.MustCompile(`\{\{[^{}]*\}\}`)
+	scriptLogPattern   = regexp.MustCompile(`addAttachmentAs(String|Bytes)\s*\(|setStringProperty\s*\(|\blog\.\w+\s*\(|\bprintln\b|System\.(out|err)\.print`)
+	scriptBodyPattern  = regexp.MustCompile(`getBody\s*\(|\bbody\b|\bpayload\b`)
+)
+
+//FindRule returns the rule by id or nil
+func FindRule(id string) *Rule {
+	for i, r := range Rules {
+		if r.ID == id {
+			return &Rules[i]
+		}
+	}
+	return nil
+}
+
+func modelFinding(flow *iflow.Flow, elementID string, message string) Finding {
+	f := Finding{Message: message, Element: elementID}
+	if m := flow.ModelFile(); m != nil {
+		f.File = m.Name
+		f.Line = lineOf(m.Content, `id="`+elementID+`"`)
+	}
+	return f
+}
+
+//lineOf returns the 1-based line of the first occurrence of s or 0
+func lineOf(content []byte, s string) int {
+	i := strings.Index(string(content), s)
+	if i < 0 {
+		return 0
+	}
+	return strings.Count(string(content[:i]), "\n") + 1
+}
+
+func channelName(c iflow.Channel) string {
+	name := c.Name
+	if name == "" {
+		name = c.ID
+	}
+	if c.Adapter != "" {
+		name += " (" + c.Adapter + ")"
+	}
+	return name
+}
+
+func checkHardcodedURL(flow *iflow.Flow, settings RuleConfig) ([]Finding, error) {
+	if flow.Model == nil {
+		return nil, nil
+	}
+	var findings []Finding
+	for _, c := range flow.Model.Channels {
+		for _, p := range c.Properties {
+			if hasHardcodedURL(p.Value) {
+				findings = append(findings, modelFinding(flow, c.ID,
+					fmt.Sprintf("channel %s: property %s contains hard-coded URL %s", channelName(c), p.Key, p.Value)))
+			}
+		}
+	}
+	return findings, nil
+}
+
+//hasHardcodedURL reports whether the value contains the URL outside of the {{parameter}} placeholders
+func hasHardcodedURL(value string) bool {
+	return urlPattern.MatchString(placeholderPattern.ReplaceAllString(value, ""))
+}
+
+func checkExternalizedAddress(flow *iflow.Flow, settings RuleConfig) ([]Finding, error) {
+	if flow.Model == nil {
+		return nil, nil
+	}
+	var findings []Finding
+	for _, c := range flow.Model.Channels {
+		if c.Direction != "Receiver" {
+			continue
+		}
+		for _, key := range settings.Properties {
+			value := c.Properties.Get(key)
+			if value == "" || strings.Contains(value, "{{") {
+				continue
+			}
+			if settings.hardcodedURLChecked && hasHardcodedURL(value) {
+				continue
+			}
+			findings = append(findings, modelFinding(flow, c.ID,
+				fmt.Sprintf("receiver channel %s: property %s is not externalized: %s", channelName(c), key, value)))
+		}
+	}
+	return findings, nil
+}
+
+func checkScriptLogPayload(flow *iflow.Flow, settings RuleConfig) ([]Finding, error) {
+	var findings []Finding
+	for _, r := range flow.Resources() {
+		ext := strings.ToLower(path.Ext(r.Name))
+		if ext != ".groovy" && ext != ".gsh" {
+			continue
+		}
+		for i, line := range strings.Split(string(r.Content), "\n") {
+			code := strings.TrimSpace(line)
+			if strings.HasPrefix(code, "//") || strings.HasPrefix(code, "*") {
+				continue
+			}
+			if scriptLogPattern.MatchString(code) && scriptBodyPattern.MatchString(code) {
+				findings = append(findings, Finding{
+					Message: "script logs the payload: " + code,
+					File:    r.Name,
+					Line:    i + 1,
+				})
+			}
+		}
+	}
+	return findings, nil
+}
+
+func checkFlowIDNaming(flow *iflow.Flow, settings RuleConfig) ([]Finding, error) {
+	pattern, err := regexp.Compile(settings.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w: pattern %s: %s", ErrInvalid, settings.Pattern, err)
+	}
+	id := flow.ID()
+	if pattern.MatchString(id) {
+		return nil, nil
+	}
+	f := Finding{
+		Message: fmt.Sprintf("flow id %s does not match the pattern %s", id, settings.Pattern),
+		File:    iflow.ManifestPath,
+	}
+	if m := flow.File(iflow.ManifestPath); m != nil {
+		f.Line = lineOf(m.Content, "Bundle-SymbolicName:")
+	}
+	return []Finding{f}, nil
+}
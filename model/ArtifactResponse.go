@@ -0,0 +1,80 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+//DesigntimeArtifact contains fields common for integration flows, value mappings, message mappings and script collections
+type DesigntimeArtifact struct {
+	Metadata    Metadata `json:"__metadata"`
+	ID          string   `json:"Id"`
+	Version     string   `json:"Version"`
+	PackageID   string   `json:"PackageId"`
+	Name        string   `json:"Name"`
+	Description string   `json:"Description"`
+}
+
+type ArtifactByIdResponse struct {
+	D DesigntimeArtifact `json:"d"`
+}
+
+func (r *ArtifactByIdResponse) Print(out io.Writer) {
+	b, err := json.MarshalIndent(r, "", "\t")
+	if err != nil {
+		panic("Could not Marshal ArtifactByIdResponse")
+	}
+	fmt.Fprintln(out, string(b))
+}
+
+type ArtifactsOfIPResponse struct {
+	D struct {
+		Results []DesigntimeArtifact `json:"results"`
+	} `json:"d"`
+}
+
+func (r *ArtifactsOfIPResponse) Print(out io.Writer) {
+
+	var responsePrinter ArtifactsOfIPResponsePrinter
+
+	for _, a := range r.D.Results {
+		description := a.Description
+		if len(description) > 40 {
+			description = description[0:37]
+			description = description + "..."
+		}
+		name := a.Name
+		if len(name) > 50 {
+			name = name[0:47]
+			name = name + "..."
+		}
+
+		artifactPrinter := ArtifactsOfIPPrinter{
+			ID:          a.ID,
+			Version:     a.Version,
+			PackageID:   a.PackageID,
+			Name:        name,
+			Description: description,
+		}
+		responsePrinter.D.Results = append(responsePrinter.D.Results, artifactPrinter)
+	}
+
+	tableprinter.Print(out, responsePrinter.D.Results)
+}
+
+type ArtifactsOfIPPrinter struct {
+	ID          string `header:"Id"`
+	Version     string `header:"Version"`
+	PackageID   string `header:"PackageId"`
+	Name        string `header:"Name"`
+	Description string `header:"Description"`
+}
+
+type ArtifactsOfIPResponsePrinter struct {
+	D struct {
+		Results []ArtifactsOfIPPrinter
+	}
+}
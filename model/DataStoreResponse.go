@@ -0,0 +1,150 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+type DataStore struct {
+	DataStoreName           string      `json:"DataStoreName"`
+	IntegrationFlow         string      `json:"IntegrationFlow"`
+	Type                    string      `json:"Type"`
+	Visibility              string      `json:"Visibility"`
+	NumberOfMessages        json.Number `json:"NumberOfMessages"`
+	NumberOfOverdueMessages json.Number `json:"NumberOfOverdueMessages"`
+}
+
+type DataStoresResponse struct {
+	D struct {
+		Results []DataStore `json:"results"`
+	} `json:"d"`
+}
+
+type DataStoreEntry struct {
+	Id              string    `json:"Id"`
+	DataStoreName   string    `json:"DataStoreName"`
+	IntegrationFlow string    `json:"IntegrationFlow"`
+	Type            string    `json:"Type"`
+	Status          string    `json:"Status"`
+	MessageId       string    `json:"MessageId"`
+	DueAt           ODataTime `json:"DueAt"`
+	CreatedAt       ODataTime `json:"CreatedAt"`
+	RetainUntil     ODataTime `json:"RetainUntil"`
+}
+
+type DataStoreEntriesResponse struct {
+	D struct {
+		Results []DataStoreEntry `json:"results"`
+	} `json:"d"`
+}
+
+type DataStoreEntryByIdResponse struct {
+	D DataStoreEntry `json:"d"`
+}
+
+type Variable struct {
+	VariableName    string    `json:"VariableName"`
+	IntegrationFlow string    `json:"IntegrationFlow"`
+	Visibility      string    `json:"Visibility"`
+	UpdatedAt       ODataTime `json:"UpdatedAt"`
+	RetainUntil     ODataTime `json:"RetainUntil"`
+}
+
+type VariablesResponse struct {
+	D struct {
+		Results []Variable `json:"results"`
+	} `json:"d"`
+}
+
+type VariableByIdResponse struct {
+	D Variable `json:"d"`
+}
+
+func (r *DataStoresResponse) Print(out io.Writer) {
+	var rows []DataStorePrinter
+	for _, s := range r.D.Results {
+		rows = append(rows, DataStorePrinter{
+			DataStoreName:   s.DataStoreName,
+			IntegrationFlow: s.IntegrationFlow,
+			Type:            s.Type,
+			Visibility:      s.Visibility,
+			Messages:        s.NumberOfMessages.String(),
+			Overdue:         s.NumberOfOverdueMessages.String(),
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+func (r *DataStoreEntriesResponse) Print(out io.Writer) {
+	var rows []DataStoreEntryPrinter
+	for _, e := range r.D.Results {
+		rows = append(rows, DataStoreEntryPrinter{
+			Id:          e.Id,
+			Status:      e.Status,
+			MessageId:   e.MessageId,
+			CreatedAt:   e.CreatedAt.String(),
+			DueAt:       e.DueAt.String(),
+			RetainUntil: e.RetainUntil.String(),
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+func (r *DataStoreEntryByIdResponse) Print(out io.Writer) {
+	b, err := json.MarshalIndent(r, "", "\t")
+	if err != nil {
+		panic("Could not Marshal DataStoreEntryByIdResponse")
+	}
+	fmt.Fprintln(out, string(b))
+}
+
+func (r *VariablesResponse) Print(out io.Writer) {
+	var rows []VariablePrinter
+	for _, v := range r.D.Results {
+		rows = append(rows, VariablePrinter{
+			VariableName:    v.VariableName,
+			IntegrationFlow: v.IntegrationFlow,
+			Visibility:      v.Visibility,
+			UpdatedAt:       v.UpdatedAt.String(),
+			RetainUntil:     v.RetainUntil.String(),
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+func (r *VariableByIdResponse) Print(out io.Writer) {
+	b, err := json.MarshalIndent(r, "", "\t")
+	if err != nil {
+		panic("Could not Marshal VariableByIdResponse")
+	}
+	fmt.Fprintln(out, string(b))
+}
+
+type DataStorePrinter struct {
+	DataStoreName   string `header:"DataStoreName"`
+	IntegrationFlow string `header:"IntegrationFlow"`
+	Type            string `header:"Type"`
+	Visibility      string `header:"Visibility"`
+	Messages        string `header:"Messages"`
+	Overdue         string `header:"Overdue"`
+}
+
+type DataStoreEntryPrinter struct {
+	Id          string `header:"Id"`
+	Status      string `header:"Status"`
+	MessageId   string `header:"MessageId"`
+	CreatedAt   string `header:"CreatedAt"`
+	DueAt       string `header:"DueAt"`
+	RetainUntil string `header:"RetainUntil"`
+}
+
+type VariablePrinter struct {
+	VariableName    string `header:"VariableName"`
+	IntegrationFlow string `header:"IntegrationFlow"`
+	Visibility      string `header:"Visibility"`
+	UpdatedAt       string `header:"UpdatedAt"`
+	RetainUntil     string `header:"RetainUntil"`
+}
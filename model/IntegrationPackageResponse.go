@@ -10,25 +10,78 @@ import (
 )
 
 type IntegrationPackage struct {
-	ID                string `json:"Id"`
-	Name              string `json:"Name"`
-	Description       string `json:"Description"`
-	ShortText         string `json:"ShortText"`
-	Version           string `json:"Version"`
-	Vendor            string `json:"Vendor"`
-	PartnerContent    bool   `json:"PartnerContent"`
-	UpdateAvailable   bool   `json:"UpdateAvailable"`
-	Mode              string `json:"Mode"`
-	SupportedPlatform string `json:"SupportedPlatform"`
-	ModifiedBy        string `json:"ModifiedBy"`
-	CreationDate      string `json:"CreationDate"`
-	ModifiedDate      string `json:"ModifiedDate"`
-	CreatedBy         string `json:"CreatedBy"`
-	Products          string `json:"Products"`
-	Keywords          string `json:"Keywords"`
-	Countries         string `json:"Countries"`
-	Industries        string `json:"Industries"`
-	LineOfBusiness    string `json:"LineOfBusiness"`
+	ID                string     `json:"Id"`
+	Name              string     `json:"Name"`
+	Description       string     `json:"Description"`
+	ShortText         string     `json:"ShortText"`
+	Version           string     `json:"Version"`
+	Vendor            string     `json:"Vendor"`
+	PartnerContent    bool       `json:"PartnerContent"`
+	UpdateAvailable   bool       `json:"UpdateAvailable"`
+	Mode              string     `json:"Mode"`
+	SupportedPlatform string     `json:"SupportedPlatform"`
+	ModifiedBy        string     `json:"ModifiedBy"`
+	CreationDate      string     `json:"CreationDate"`
+	ModifiedDate      string     `json:"ModifiedDate"`
+	CreatedBy         string     `json:"CreatedBy"`
+	Products          string     `json:"Products"`
+	Keywords          string     `json:"Keywords"`
+	Countries         string     `json:"Countries"`
+	Industries        string     `json:"Industries"`
+	LineOfBusiness    string     `json:"LineOfBusiness"`
+	CustomTags        CustomTags `json:"CustomTags,omitempty"`
+}
+
+//CustomTag - custom tag of the integration package
+type CustomTag struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+//CustomTags - the navigation property is returned as deferred link unless expanded
+type CustomTags []CustomTag
+
+func (t *CustomTags) UnmarshalJSON(b []byte) error {
+	var expanded struct {
+		Results []CustomTag `json:"results"`
+	}
+	if len(b) > 0 && b[0] == '[' {
+		return json.Unmarshal(b, (*[]CustomTag)(t))
+	}
+	if err := json.Unmarshal(b, &expanded); err != nil {
+		return err
+	}
+	*t = expanded.Results
+	return nil
+}
+
+//Get returns the value of the tag and true when the tag is set
+func (t CustomTags) Get(name string) (string, bool) {
+	for _, tag := range t {
+		if tag.Name == name {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+type CustomTagsResponse struct {
+	D struct {
+		Results CustomTags `json:"results"`
+	} `json:"d"`
+}
+
+func (r *CustomTagsResponse) Print(out io.Writer) {
+	var rows []CustomTagPrinter
+	for _, tag := range r.D.Results {
+		rows = append(rows, CustomTagPrinter(tag))
+	}
+	tableprinter.Print(out, rows)
+}
+
+type CustomTagPrinter struct {
+	Name  string `header:"Name"`
+	Value string `header:"Value"`
 }
 
 type IPResponse struct {
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+type Queue struct {
+	Name          string      `json:"Name"`
+	Active        bool        `json:"Active"`
+	State         json.Number `json:"State"`
+	NumbOfMsgs    json.Number `json:"NumbOfMsgs"`
+	Size          json.Number `json:"Size"`
+	MaxNumbOfMsgs json.Number `json:"MaxNumbOfMsgs"`
+	MaxSize       json.Number `json:"MaxSize"`
+}
+
+type QueuesResponse struct {
+	D struct {
+		Results []Queue `json:"results"`
+	} `json:"d"`
+}
+
+//Usage returns capacity usage of the queue in percent, the higher of the message count and the size usage
+func (q Queue) Usage() float64 {
+	usage := 0.0
+	for _, pair := range [][2]json.Number{{q.NumbOfMsgs, q.MaxNumbOfMsgs}, {q.Size, q.MaxSize}} {
+		value, err1 := pair[0].Float64()
+		max, err2 := pair[1].Float64()
+		if err1 != nil || err2 != nil || max <= 0 {
+			continue
+		}
+		if u := value / max * 100; u > usage {
+			usage = u
+		}
+	}
+	return usage
+}
+
+//StateText returns the description of the queue state
+func (q Queue) StateText() string {
+	switch q.State.String() {
+	case "0", "":
+		return "OK"
+	case "1":
+		return "Near capacity"
+	case "2":
+		return "Exhausted"
+	}
+	return q.State.String()
+}
+
+type JmsMessage struct {
+	Msgid          string    `json:"Msgid"`
+	Name           string    `json:"Name"`
+	Mplid          string    `json:"Mplid"`
+	Status         string    `json:"Status"`
+	Failed         bool      `json:"Failed"`
+	RetryCount     int       `json:"RetryCount"`
+	CreatedAt      ODataTime `json:"CreatedAt"`
+	NextRetry      ODataTime `json:"NextRetry"`
+	DueAt          ODataTime `json:"DueAt"`
+	ExpirationDate ODataTime `json:"ExpirationDate"`
+}
+
+type JmsMessagesResponse struct {
+	D struct {
+		Results []JmsMessage `json:"results"`
+	} `json:"d"`
+}
+
+func (r *QueuesResponse) Print(out io.Writer) {
+	var rows []QueuePrinter
+	for _, q := range r.D.Results {
+		active := "no"
+		if q.Active {
+			active = "yes"
+		}
+		rows = append(rows, QueuePrinter{
+			Name:     q.Name,
+			Active:   active,
+			State:    q.StateText(),
+			Entries:  q.NumbOfMsgs.String(),
+			Size:     q.Size.String(),
+			Capacity: fmt.Sprintf("%.1f%%", q.Usage()),
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+func (r *JmsMessagesResponse) Print(out io.Writer) {
+	var rows []JmsMessagePrinter
+	for _, m := range r.D.Results {
+		rows = append(rows, JmsMessagePrinter{
+			Msgid:      m.Msgid,
+			MessageId:  m.Mplid,
+			Status:     m.Status,
+			Retries:    fmt.Sprint(m.RetryCount),
+			CreatedAt:  m.CreatedAt.String(),
+			NextRetry:  m.NextRetry.String(),
+			Expiration: m.ExpirationDate.String(),
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+type QueuePrinter struct {
+	Name     string `header:"Name"`
+	Active   string `header:"Active"`
+	State    string `header:"State"`
+	Entries  string `header:"Entries"`
+	Size     string `header:"Size"`
+	Capacity string `header:"Capacity"`
+}
+
+type JmsMessagePrinter struct {
+	Msgid      string `header:"Msgid"`
+	MessageId  string `header:"MessageGuid"`
+	Status     string `header:"Status"`
+	Retries    string `header:"Retries"`
+	CreatedAt  string `header:"CreatedAt"`
+	NextRetry  string `header:"NextRetry"`
+	Expiration string `header:"Expiration"`
+}
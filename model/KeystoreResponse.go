@@ -0,0 +1,70 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+type KeystoreEntry struct {
+	Hexalias           string      `json:"Hexalias"`
+	Alias              string      `json:"Alias"`
+	Type               string      `json:"Type"`
+	Owner              string      `json:"Owner"`
+	Status             string      `json:"Status"`
+	KeyType            string      `json:"KeyType"`
+	KeySize            json.Number `json:"KeySize"`
+	ValidNotBefore     ODataTime   `json:"ValidNotBefore"`
+	ValidNotAfter      ODataTime   `json:"ValidNotAfter"`
+	SerialNumber       string      `json:"SerialNumber"`
+	SignatureAlgorithm string      `json:"SignatureAlgorithm"`
+	Validity           string      `json:"Validity"`
+	SubjectDN          string      `json:"SubjectDN"`
+	IssuerDN           string      `json:"IssuerDN"`
+	FingerprintSha1    string      `json:"FingerprintSha1"`
+	FingerprintSha256  string      `json:"FingerprintSha256"`
+	LastModifiedBy     string      `json:"LastModifiedBy"`
+	LastModifiedTime   ODataTime   `json:"LastModifiedTime"`
+}
+
+type KeystoreEntriesResponse struct {
+	D struct {
+		Results []KeystoreEntry `json:"results"`
+	} `json:"d"`
+}
+
+type KeystoreEntryByIdResponse struct {
+	D KeystoreEntry `json:"d"`
+}
+
+func (r *KeystoreEntriesResponse) Print(out io.Writer) {
+	var responsePrinter KeystoreEntriesResponsePrinter
+
+	for _, e := range r.D.Results {
+		entryPrinter := KeystoreEntryPrinter{
+			Alias:      e.Alias,
+			Type:       e.Type,
+			Subject:    e.SubjectDN,
+			Issuer:     e.IssuerDN,
+			ValidUntil: e.ValidNotAfter.String(),
+		}
+		responsePrinter.D.Results = append(responsePrinter.D.Results, entryPrinter)
+	}
+
+	tableprinter.Print(out, responsePrinter.D.Results)
+}
+
+type KeystoreEntryPrinter struct {
+	Alias      string `header:"Alias"`
+	Type       string `header:"Type"`
+	Subject    string `header:"Subject"`
+	Issuer     string `header:"Issuer"`
+	ValidUntil string `header:"ValidUntil"`
+}
+
+type KeystoreEntriesResponsePrinter struct {
+	D struct {
+		Results []KeystoreEntryPrinter
+	}
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+type LogFile struct {
+	Name         string      `json:"Name"`
+	Application  string      `json:"Application"`
+	LastModified ODataTime   `json:"LastModified"`
+	ContentType  string      `json:"ContentType"`
+	LogFileType  string      `json:"LogFileType"`
+	NodeScope    string      `json:"NodeScope"`
+	Size         json.Number `json:"Size"`
+}
+
+type LogFilesResponse struct {
+	D struct {
+		Results []LogFile `json:"results"`
+	} `json:"d"`
+}
+
+func (r *LogFilesResponse) Print(out io.Writer) {
+	var rows []LogFilePrinter
+	for _, f := range r.D.Results {
+		rows = append(rows, LogFilePrinter{
+			Name:         f.Name,
+			Application:  f.Application,
+			LogFileType:  f.LogFileType,
+			Size:         f.Size.String(),
+			LastModified: f.LastModified.String(),
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+type LogFilePrinter struct {
+	Name         string `header:"Name"`
+	Application  string `header:"Application"`
+	LogFileType  string `header:"Type"`
+	Size         string `header:"Size"`
+	LastModified string `header:"LastModified"`
+}
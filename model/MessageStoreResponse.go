@@ -0,0 +1,106 @@
+package model
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+type IdempotentRepositoryEntry struct {
+	HexSource      string    `json:"HexSource"`
+	Source         string    `json:"Source"`
+	HexEntry       string    `json:"HexEntry"`
+	Entry          string    `json:"Entry"`
+	Component      string    `json:"Component"`
+	CreationTime   ODataTime `json:"CreationTime"`
+	ExpirationTime ODataTime `json:"ExpirationTime"`
+}
+
+type IdempotentRepositoryEntriesResponse struct {
+	D struct {
+		Results []IdempotentRepositoryEntry `json:"results"`
+	} `json:"d"`
+}
+
+type MessageStoreEntry struct {
+	Id             string    `json:"Id"`
+	MessageGuid    string    `json:"MessageGuid"`
+	MessageStoreId string    `json:"MessageStoreId"`
+	TimeStamp      ODataTime `json:"TimeStamp"`
+	HasAttachments bool      `json:"HasAttachments"`
+}
+
+type MessageStoreEntriesResponse struct {
+	D struct {
+		Results []MessageStoreEntry `json:"results"`
+	} `json:"d"`
+}
+
+type MessageStoreEntryAttachment struct {
+	Id          string `json:"Id"`
+	Name        string `json:"Name"`
+	ContentType string `json:"ContentType"`
+}
+
+type MessageStoreEntryAttachmentsResponse struct {
+	D struct {
+		Results []MessageStoreEntryAttachment `json:"results"`
+	} `json:"d"`
+}
+
+func (r *IdempotentRepositoryEntriesResponse) Print(out io.Writer) {
+	var rows []IdempotentRepositoryEntryPrinter
+	for _, e := range r.D.Results {
+		rows = append(rows, IdempotentRepositoryEntryPrinter{
+			Source:         e.Source,
+			Entry:          e.Entry,
+			Component:      e.Component,
+			CreationTime:   e.CreationTime.String(),
+			ExpirationTime: e.ExpirationTime.String(),
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+func (r *MessageStoreEntriesResponse) Print(out io.Writer) {
+	var rows []MessageStoreEntryPrinter
+	for _, e := range r.D.Results {
+		rows = append(rows, MessageStoreEntryPrinter{
+			Id:             e.Id,
+			MessageStoreId: e.MessageStoreId,
+			TimeStamp:      e.TimeStamp.String(),
+			HasAttachments: fmt.Sprint(e.HasAttachments),
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+func (r *MessageStoreEntryAttachmentsResponse) Print(out io.Writer) {
+	var rows []MessageStoreEntryAttachmentPrinter
+	for _, a := range r.D.Results {
+		rows = append(rows, MessageStoreEntryAttachmentPrinter(a))
+	}
+	tableprinter.Print(out, rows)
+}
+
+type IdempotentRepositoryEntryPrinter struct {
+	Source         string `header:"Source"`
+	Entry          string `header:"Entry"`
+	Component      string `header:"Component"`
+	CreationTime   string `header:"CreationTime"`
+	ExpirationTime string `header:"ExpirationTime"`
+}
+
+type MessageStoreEntryPrinter struct {
+	Id             string `header:"Id"`
+	MessageStoreId string `header:"MessageStoreId"`
+	TimeStamp      string `header:"TimeStamp"`
+	HasAttachments string `header:"HasAttachments"`
+}
+
+type MessageStoreEntryAttachmentPrinter struct {
+	Id          string `header:"Id"`
+	Name        string `header:"Name"`
+	ContentType string `header:"ContentType"`
+}
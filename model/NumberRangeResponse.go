@@ -0,0 +1,83 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+//NumberRange - NumberRanges entity, the numeric values are sent by the API as strings
+type NumberRange struct {
+	Name         string      `json:"Name"`
+	Description  string      `json:"Description"`
+	MinValue     json.Number `json:"MinValue"`
+	MaxValue     json.Number `json:"MaxValue"`
+	FieldLength  json.Number `json:"FieldLength"`
+	Rotate       string      `json:"Rotate"`
+	CurrentValue json.Number `json:"CurrentValue"`
+	DeployedBy   string      `json:"DeployedBy"`
+	DeployedOn   ODataTime   `json:"DeployedOn"`
+}
+
+type NumberRangesResponse struct {
+	D struct {
+		Results []NumberRange `json:"results"`
+	} `json:"d"`
+}
+
+type NumberRangeByIdResponse struct {
+	D NumberRange `json:"d"`
+}
+
+//NumberRangeDocument is the file format of the number range export and import
+type NumberRangeDocument struct {
+	NumberRanges []NumberRangeDoc `json:"numberRanges"`
+}
+
+//NumberRangeDoc - the current value is set only when exported with values
+type NumberRangeDoc struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	MinValue     string `json:"minValue"`
+	MaxValue     string `json:"maxValue"`
+	FieldLength  string `json:"fieldLength"`
+	Rotate       string `json:"rotate"`
+	CurrentValue string `json:"currentValue,omitempty"`
+}
+
+func (r *NumberRangesResponse) Print(out io.Writer) {
+	var rows []NumberRangePrinter
+	for _, n := range r.D.Results {
+		rows = append(rows, NumberRangePrinter{
+			Name:         n.Name,
+			Description:  n.Description,
+			MinValue:     n.MinValue.String(),
+			MaxValue:     n.MaxValue.String(),
+			CurrentValue: n.CurrentValue.String(),
+			Rotate:       n.Rotate,
+			DeployedOn:   n.DeployedOn.String(),
+		})
+	}
+
+	tableprinter.Print(out, rows)
+}
+
+func (r *NumberRangeByIdResponse) Print(out io.Writer) {
+	b, err := json.MarshalIndent(r, "", "\t")
+	if err != nil {
+		panic("Could not Marshal NumberRangeByIdResponse")
+	}
+	fmt.Fprintln(out, string(b))
+}
+
+type NumberRangePrinter struct {
+	Name         string `header:"Name"`
+	Description  string `header:"Description"`
+	MinValue     string `header:"MinValue"`
+	MaxValue     string `header:"MaxValue"`
+	CurrentValue string `header:"CurrentValue"`
+	Rotate       string `header:"Rotate"`
+	DeployedOn   string `header:"DeployedOn"`
+}
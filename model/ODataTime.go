@@ -0,0 +1,78 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//ODataTime is the date-time value of the OData v2 API, sent as "/Date(1672531200000)/",
+//"/Date(1672531200000+0060)/", as a number of milliseconds or as RFC 3339 string
+type ODataTime struct {
+	time.Time
+}
+
+var odataDateRegexp = regexp.MustCompile(`^/Date\((-?\d+)([+-]\d{4})?\)/$`)
+
+func (t *ODataTime) UnmarshalJSON(b []byte) error {
+	s := strings.TrimSpace(string(b))
+	if s == "null" || s == `""` {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	if !strings.HasPrefix(s, `"`) {
+		ms, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid OData date-time %s", s)
+		}
+		t.Time = time.UnixMilli(ms).UTC()
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+	return t.parse(str)
+}
+
+func (t *ODataTime) parse(str string) error {
+	if m := odataDateRegexp.FindStringSubmatch(str); m != nil {
+		ms, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return err
+		}
+		t.Time = time.UnixMilli(ms).UTC()
+		return nil
+	}
+	if ms, err := strconv.ParseInt(str, 10, 64); err == nil {
+		t.Time = time.UnixMilli(ms).UTC()
+		return nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999", "2006-01-02T15:04:05"} {
+		if parsed, err := time.Parse(layout, str); err == nil {
+			t.Time = parsed.UTC()
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid OData date-time %q", str)
+}
+
+func (t ODataTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(time.RFC3339))
+}
+
+//String returns the time in format used in the printed tables
+func (t ODataTime) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
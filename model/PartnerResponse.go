@@ -0,0 +1,203 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+type Partner struct {
+	Pid string `json:"Pid"`
+}
+
+type PartnersResponse struct {
+	D struct {
+		Results []Partner `json:"results"`
+	} `json:"d"`
+}
+
+//StringParameter - StringParameters entity of the Partner Directory
+type StringParameter struct {
+	Pid              string    `json:"Pid"`
+	Id               string    `json:"Id"`
+	Value            string    `json:"Value"`
+	CreatedBy        string    `json:"CreatedBy,omitempty"`
+	CreatedTime      ODataTime `json:"CreatedTime"`
+	LastModifiedBy   string    `json:"LastModifiedBy,omitempty"`
+	LastModifiedTime ODataTime `json:"LastModifiedTime"`
+}
+
+type StringParametersResponse struct {
+	D struct {
+		Results []StringParameter `json:"results"`
+	} `json:"d"`
+}
+
+type StringParameterByIdResponse struct {
+	D StringParameter `json:"d"`
+}
+
+//BinaryParameter - BinaryParameters entity of the Partner Directory, the value is base64 encoded
+type BinaryParameter struct {
+	Pid              string    `json:"Pid"`
+	Id               string    `json:"Id"`
+	ContentType      string    `json:"ContentType"`
+	Value            string    `json:"Value"`
+	CreatedBy        string    `json:"CreatedBy,omitempty"`
+	CreatedTime      ODataTime `json:"CreatedTime"`
+	LastModifiedBy   string    `json:"LastModifiedBy,omitempty"`
+	LastModifiedTime ODataTime `json:"LastModifiedTime"`
+}
+
+type BinaryParametersResponse struct {
+	D struct {
+		Results []BinaryParameter `json:"results"`
+	} `json:"d"`
+}
+
+type BinaryParameterByIdResponse struct {
+	D BinaryParameter `json:"d"`
+}
+
+//AlternativePartner - AlternativePartners entity, maps agency, scheme and id to the partner id
+type AlternativePartner struct {
+	Hexagency        string    `json:"Hexagency"`
+	Hexscheme        string    `json:"Hexscheme"`
+	Hexid            string    `json:"Hexid"`
+	Agency           string    `json:"Agency"`
+	Scheme           string    `json:"Scheme"`
+	Id               string    `json:"Id"`
+	Pid              string    `json:"Pid"`
+	LastModifiedBy   string    `json:"LastModifiedBy,omitempty"`
+	LastModifiedTime ODataTime `json:"LastModifiedTime"`
+}
+
+type AlternativePartnersResponse struct {
+	D struct {
+		Results []AlternativePartner `json:"results"`
+	} `json:"d"`
+}
+
+type AlternativePartnerByIdResponse struct {
+	D AlternativePartner `json:"d"`
+}
+
+func (r *PartnersResponse) Print(out io.Writer) {
+	var rows []PartnerPrinter
+	for _, p := range r.D.Results {
+		rows = append(rows, PartnerPrinter{Pid: p.Pid})
+	}
+	tableprinter.Print(out, rows)
+}
+
+func (r *StringParametersResponse) Print(out io.Writer) {
+	var rows []StringParameterPrinter
+	for _, p := range r.D.Results {
+		rows = append(rows, StringParameterPrinter{
+			Pid:              p.Pid,
+			Id:               p.Id,
+			Value:            p.Value,
+			LastModifiedBy:   p.LastModifiedBy,
+			LastModifiedTime: p.LastModifiedTime.String(),
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+func (r *StringParameterByIdResponse) Print(out io.Writer) {
+	b, err := json.MarshalIndent(r, "", "\t")
+	if err != nil {
+		panic("Could not Marshal StringParameterByIdResponse")
+	}
+	fmt.Fprintln(out, string(b))
+}
+
+func (r *BinaryParametersResponse) Print(out io.Writer) {
+	var rows []BinaryParameterPrinter
+	for _, p := range r.D.Results {
+		rows = append(rows, BinaryParameterPrinter{
+			Pid:              p.Pid,
+			Id:               p.Id,
+			ContentType:      p.ContentType,
+			LastModifiedBy:   p.LastModifiedBy,
+			LastModifiedTime: p.LastModifiedTime.String(),
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+func (r *AlternativePartnersResponse) Print(out io.Writer) {
+	var rows []AlternativePartnerPrinter
+	for _, p := range r.D.Results {
+		rows = append(rows, AlternativePartnerPrinter{
+			Agency: p.Agency,
+			Scheme: p.Scheme,
+			Id:     p.Id,
+			Pid:    p.Pid,
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+func (r *AlternativePartnerByIdResponse) Print(out io.Writer) {
+	b, err := json.MarshalIndent(r, "", "\t")
+	if err != nil {
+		panic("Could not Marshal AlternativePartnerByIdResponse")
+	}
+	fmt.Fprintln(out, string(b))
+}
+
+type PartnerPrinter struct {
+	Pid string `header:"Pid"`
+}
+
+type StringParameterPrinter struct {
+	Pid              string `header:"Pid"`
+	Id               string `header:"Id"`
+	Value            string `header:"Value"`
+	LastModifiedBy   string `header:"LastModifiedBy"`
+	LastModifiedTime string `header:"LastModifiedTime"`
+}
+
+type BinaryParameterPrinter struct {
+	Pid              string `header:"Pid"`
+	Id               string `header:"Id"`
+	ContentType      string `header:"ContentType"`
+	LastModifiedBy   string `header:"LastModifiedBy"`
+	LastModifiedTime string `header:"LastModifiedTime"`
+}
+
+type AlternativePartnerPrinter struct {
+	Agency string `header:"Agency"`
+	Scheme string `header:"Scheme"`
+	Id     string `header:"Id"`
+	Pid    string `header:"Pid"`
+}
+
+//PartnerDocument is the file format of the partner export and import
+type PartnerDocument struct {
+	Pid                 string                      `json:"pid" yaml:"pid"`
+	StringParameters    []PartnerStringParameterDoc `json:"stringParameters,omitempty" yaml:"stringParameters,omitempty"`
+	BinaryParameters    []PartnerBinaryParameterDoc `json:"binaryParameters,omitempty" yaml:"binaryParameters,omitempty"`
+	AlternativePartners []PartnerAlternativeDoc     `json:"alternativePartners,omitempty" yaml:"alternativePartners,omitempty"`
+}
+
+type PartnerStringParameterDoc struct {
+	Id    string `json:"id" yaml:"id"`
+	Value string `json:"value" yaml:"value"`
+}
+
+//PartnerBinaryParameterDoc - the value is base64 encoded content
+type PartnerBinaryParameterDoc struct {
+	Id          string `json:"id" yaml:"id"`
+	ContentType string `json:"contentType" yaml:"contentType"`
+	Value       string `json:"value" yaml:"value"`
+}
+
+type PartnerAlternativeDoc struct {
+	Agency string `json:"agency" yaml:"agency"`
+	Scheme string `json:"scheme" yaml:"scheme"`
+	Id     string `json:"id" yaml:"id"`
+}
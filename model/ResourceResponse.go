@@ -0,0 +1,58 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+type Resource struct {
+	Name                   string      `json:"Name"`
+	ResourceType           string      `json:"ResourceType"`
+	ReferencedResourceType string      `json:"ReferencedResourceType"`
+	ResourceContent        string      `json:"ResourceContent"`
+	ResourceSize           json.Number `json:"ResourceSize"`
+	ResourceSizeUnit       string      `json:"ResourceSizeUnit"`
+}
+
+type ResourcesResponse struct {
+	D struct {
+		Results []Resource `json:"results"`
+	} `json:"d"`
+}
+
+type ResourceByIdResponse struct {
+	D Resource `json:"d"`
+}
+
+func (r *ResourcesResponse) Print(out io.Writer) {
+	var responsePrinter ResourcesResponsePrinter
+
+	for _, res := range r.D.Results {
+		size := res.ResourceSize.String()
+		if res.ResourceSizeUnit != "" {
+			size = size + " " + res.ResourceSizeUnit
+		}
+		resourcePrinter := ResourcePrinter{
+			Name:         res.Name,
+			ResourceType: res.ResourceType,
+			Size:         size,
+		}
+		responsePrinter.D.Results = append(responsePrinter.D.Results, resourcePrinter)
+	}
+
+	tableprinter.Print(out, responsePrinter.D.Results)
+}
+
+type ResourcePrinter struct {
+	Name         string `header:"Name"`
+	ResourceType string `header:"ResourceType"`
+	Size         string `header:"Size"`
+}
+
+type ResourcesResponsePrinter struct {
+	D struct {
+		Results []ResourcePrinter
+	}
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type RuntimeLogConfiguration struct {
+	ArtifactId string    `json:"ArtifactId"`
+	LogLevel   string    `json:"LogLevel"`
+	ExpiresAt  ODataTime `json:"ExpiresAt"`
+}
+
+type RuntimeLogConfigurationResponse struct {
+	D RuntimeLogConfiguration `json:"d"`
+}
+
+func (r *RuntimeLogConfigurationResponse) Print(out io.Writer) {
+	b, err := json.MarshalIndent(r, "", "\t")
+	if err != nil {
+		panic("Could not Marshal RuntimeLogConfigurationResponse")
+	}
+	fmt.Fprintln(out, string(b))
+}
+
+type RuntimeArtifact struct {
+	Id         string    `json:"Id"`
+	Version    string    `json:"Version"`
+	Name       string    `json:"Name"`
+	Type       string    `json:"Type"`
+	DeployedBy string    `json:"DeployedBy"`
+	DeployedOn ODataTime `json:"DeployedOn"`
+	Status     string    `json:"Status"`
+}
+
+type RuntimeArtifactByIdResponse struct {
+	D RuntimeArtifact `json:"d"`
+}
+
+type MessageProcessingLogRun struct {
+	Id           string    `json:"Id"`
+	RunStart     ODataTime `json:"RunStart"`
+	RunStop      ODataTime `json:"RunStop"`
+	LogLevel     string    `json:"LogLevel"`
+	OverallState string    `json:"OverallState"`
+}
+
+type MessageProcessingLogRunsResponse struct {
+	D struct {
+		Results []MessageProcessingLogRun `json:"results"`
+	} `json:"d"`
+}
+
+type MessageProcessingLogRunStep struct {
+	RunId       string    `json:"RunId"`
+	ChildCount  int       `json:"ChildCount"`
+	StepStart   ODataTime `json:"StepStart"`
+	StepStop    ODataTime `json:"StepStop"`
+	StepId      string    `json:"StepId"`
+	ModelStepId string    `json:"ModelStepId"`
+	BranchId    string    `json:"BranchId"`
+	Status      string    `json:"Status"`
+	Error       string    `json:"Error"`
+	Activity    string    `json:"Activity"`
+}
+
+type MessageProcessingLogRunStepsResponse struct {
+	D struct {
+		Results []MessageProcessingLogRunStep `json:"results"`
+	} `json:"d"`
+}
+
+type TraceMessage struct {
+	TraceId     json.Number `json:"TraceId"`
+	MplId       string      `json:"MplId"`
+	RunId       string      `json:"RunId"`
+	ChildCount  int         `json:"ChildCount"`
+	ModelStepId string      `json:"ModelStepId"`
+	PayloadSize json.Number `json:"PayloadSize"`
+	MimeType    string      `json:"MimeType"`
+}
+
+type TraceMessagesResponse struct {
+	D struct {
+		Results []TraceMessage `json:"results"`
+	} `json:"d"`
+}
+
+//TraceMessageProperty - header or exchange property of the trace message
+type TraceMessageProperty struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+type TraceMessagePropertiesResponse struct {
+	D struct {
+		Results []TraceMessageProperty `json:"results"`
+	} `json:"d"`
+}
@@ -0,0 +1,167 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+type SecurityArtifactDescriptor struct {
+	Type       string    `json:"Type"`
+	DeployedBy string    `json:"DeployedBy"`
+	DeployedOn ODataTime `json:"DeployedOn"`
+	Status     string    `json:"Status"`
+}
+
+//UserCredential - UserCredentials entity, the password is never returned by the API
+type UserCredential struct {
+	Name                       string                     `json:"Name"`
+	Kind                       string                     `json:"Kind"`
+	Description                string                     `json:"Description"`
+	User                       string                     `json:"User"`
+	Password                   string                     `json:"Password,omitempty"`
+	CompanyId                  string                     `json:"CompanyId"`
+	SecurityArtifactDescriptor SecurityArtifactDescriptor `json:"SecurityArtifactDescriptor"`
+}
+
+//OAuth2ClientCredential - OAuth2ClientCredentials entity, the client secret is never returned by the API
+type OAuth2ClientCredential struct {
+	Name                       string                     `json:"Name"`
+	Description                string                     `json:"Description"`
+	TokenServiceUrl            string                     `json:"TokenServiceUrl"`
+	ClientId                   string                     `json:"ClientId"`
+	ClientSecret               string                     `json:"ClientSecret,omitempty"`
+	ClientAuthentication       string                     `json:"ClientAuthentication"`
+	Scope                      string                     `json:"Scope"`
+	ScopeContentType           string                     `json:"ScopeContentType"`
+	Resource                   string                     `json:"Resource"`
+	Audience                   string                     `json:"Audience"`
+	SecurityArtifactDescriptor SecurityArtifactDescriptor `json:"SecurityArtifactDescriptor"`
+}
+
+type UserCredentialsResponse struct {
+	D struct {
+		Results []UserCredential `json:"results"`
+	} `json:"d"`
+}
+
+type UserCredentialByIdResponse struct {
+	D UserCredential `json:"d"`
+}
+
+type OAuth2ClientCredentialsResponse struct {
+	D struct {
+		Results []OAuth2ClientCredential `json:"results"`
+	} `json:"d"`
+}
+
+type OAuth2ClientCredentialByIdResponse struct {
+	D OAuth2ClientCredential `json:"d"`
+}
+
+type CredentialPrinter struct {
+	Name       string `header:"Name"`
+	Type       string `header:"Type"`
+	Kind       string `header:"Kind"`
+	User       string `header:"User/ClientId"`
+	Status     string `header:"Status"`
+	DeployedOn string `header:"DeployedOn"`
+}
+
+//PrintCredentials prints user credentials and OAuth2 client credentials in one table
+func PrintCredentials(out io.Writer, users *UserCredentialsResponse, oauth2 *OAuth2ClientCredentialsResponse) {
+	var rows []CredentialPrinter
+	if users != nil {
+		for _, c := range users.D.Results {
+			rows = append(rows, CredentialPrinter{
+				Name:       c.Name,
+				Type:       "user",
+				Kind:       c.Kind,
+				User:       c.User,
+				Status:     c.SecurityArtifactDescriptor.Status,
+				DeployedOn: c.SecurityArtifactDescriptor.DeployedOn.String(),
+			})
+		}
+	}
+	if oauth2 != nil {
+		for _, c := range oauth2.D.Results {
+			rows = append(rows, CredentialPrinter{
+				Name:       c.Name,
+				Type:       "oauth2",
+				Kind:       c.ClientAuthentication,
+				User:       c.ClientId,
+				Status:     c.SecurityArtifactDescriptor.Status,
+				DeployedOn: c.SecurityArtifactDescriptor.DeployedOn.String(),
+			})
+		}
+	}
+
+	tableprinter.Print(out, rows)
+}
+
+//Print prints the credential as JSON, the password is cleared before printing
+func (r *UserCredentialByIdResponse) Print(out io.Writer) {
+	printed := *r
+	printed.D.Password = ""
+	b, err := json.MarshalIndent(printed, "", "\t")
+	if err != nil {
+		panic("Could not Marshal UserCredentialByIdResponse")
+	}
+	fmt.Fprintln(out, string(b))
+}
+
+//Print prints the credential as JSON, the client secret is cleared before printing
+func (r *OAuth2ClientCredentialByIdResponse) Print(out io.Writer) {
+	printed := *r
+	printed.D.ClientSecret = ""
+	b, err := json.MarshalIndent(printed, "", "\t")
+	if err != nil {
+		panic("Could not Marshal OAuth2ClientCredentialByIdResponse")
+	}
+	fmt.Fprintln(out, string(b))
+}
+
+//SecureParameter - SecureParameters entity, the value is never returned by the API
+type SecureParameter struct {
+	Name        string    `json:"Name"`
+	Description string    `json:"Description"`
+	SecureParam string    `json:"SecureParam,omitempty"`
+	DeployedBy  string    `json:"DeployedBy"`
+	DeployedOn  ODataTime `json:"DeployedOn"`
+	Status      string    `json:"Status"`
+}
+
+type SecureParametersResponse struct {
+	D struct {
+		Results []SecureParameter `json:"results"`
+	} `json:"d"`
+}
+
+type SecureParameterByIdResponse struct {
+	D SecureParameter `json:"d"`
+}
+
+func (r *SecureParametersResponse) Print(out io.Writer) {
+	var rows []SecureParameterPrinter
+	for _, p := range r.D.Results {
+		rows = append(rows, SecureParameterPrinter{
+			Name:        p.Name,
+			Description: p.Description,
+			Status:      p.Status,
+			DeployedBy:  p.DeployedBy,
+			DeployedOn:  p.DeployedOn.String(),
+		})
+	}
+
+	tableprinter.Print(out, rows)
+}
+
+type SecureParameterPrinter struct {
+	Name        string `header:"Name"`
+	Description string `header:"Description"`
+	Status      string `header:"Status"`
+	DeployedBy  string `header:"DeployedBy"`
+	DeployedOn  string `header:"DeployedOn"`
+}
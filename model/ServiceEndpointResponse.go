@@ -0,0 +1,72 @@
+package model
+
+import (
+	"io"
+	"strings"
+
+	"github.com/lensesio/tableprinter"
+)
+
+type ServiceEndpoint struct {
+	Id          string    `json:"Id"`
+	Name        string    `json:"Name"`
+	Title       string    `json:"Title"`
+	Version     string    `json:"Version"`
+	Summary     string    `json:"Summary"`
+	Description string    `json:"Description"`
+	LastUpdated ODataTime `json:"LastUpdated"`
+	Protocol    string    `json:"Protocol"`
+	EntryPoints struct {
+		Results []EntryPoint `json:"results"`
+	} `json:"EntryPoints"`
+	ApiDefinitions struct {
+		Results []APIDefinition `json:"results"`
+	} `json:"ApiDefinitions"`
+}
+
+type EntryPoint struct {
+	Name                  string `json:"Name"`
+	Url                   string `json:"Url"`
+	Type                  string `json:"Type"`
+	AdditionalInformation string `json:"AdditionalInformation"`
+}
+
+type APIDefinition struct {
+	Name string `json:"Name"`
+	Url  string `json:"Url"`
+}
+
+type ServiceEndpointsResponse struct {
+	D struct {
+		Results []ServiceEndpoint `json:"results"`
+	} `json:"d"`
+}
+
+func (r *ServiceEndpointsResponse) Print(out io.Writer) {
+	var rows []ServiceEndpointPrinter
+	for _, e := range r.D.Results {
+		var entryPoints, definitions []string
+		for _, p := range e.EntryPoints.Results {
+			entryPoints = append(entryPoints, p.Url)
+		}
+		for _, d := range e.ApiDefinitions.Results {
+			definitions = append(definitions, d.Url)
+		}
+		rows = append(rows, ServiceEndpointPrinter{
+			Id:          e.Id,
+			Name:        e.Name,
+			Protocol:    e.Protocol,
+			EntryPoints: strings.Join(entryPoints, ", "),
+			Definitions: strings.Join(definitions, ", "),
+		})
+	}
+	tableprinter.Print(out, rows)
+}
+
+type ServiceEndpointPrinter struct {
+	Id          string `header:"Id"`
+	Name        string `header:"Name"`
+	Protocol    string `header:"Protocol"`
+	EntryPoints string `header:"EntryPoints"`
+	Definitions string `header:"Definitions"`
+}
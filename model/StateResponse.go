@@ -0,0 +1,90 @@
+package model
+
+import (
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+//StateDocument is the file format of the declarative tenant state used by plan and apply, the state is defined per tenant key
+type StateDocument struct {
+	Tenants map[string]TenantState `json:"tenants" yaml:"tenants"`
+}
+
+//TenantState - desired state of the tenant
+type TenantState struct {
+	Credentials []StateCredential `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+	Packages    []StatePackage    `json:"packages,omitempty" yaml:"packages,omitempty"`
+}
+
+//StateCredential - user credential, the password is not stored in the state file but read from the environment variable PasswordEnv.
+//The password is set when the credential is created, or updated together with the user, description or kind,
+//a changed password alone is not detected by plan.
+type StateCredential struct {
+	Name        string `json:"name" yaml:"name"`
+	Kind        string `json:"kind,omitempty" yaml:"kind,omitempty"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	User        string `json:"user" yaml:"user"`
+	PasswordEnv string `json:"passwordEnv" yaml:"passwordEnv"`
+}
+
+//StatePackage - integration package with its integration flows
+type StatePackage struct {
+	ID          string      `json:"id" yaml:"id"`
+	Name        string      `json:"name,omitempty" yaml:"name,omitempty"`
+	ShortText   string      `json:"shortText,omitempty" yaml:"shortText,omitempty"`
+	Description string      `json:"description,omitempty" yaml:"description,omitempty"`
+	Flows       []StateFlow `json:"flows,omitempty" yaml:"flows,omitempty"`
+}
+
+//StateFlow - integration flow, the source is the directory created by flow pull or the zip file,
+//relative paths are resolved against the directory of the state file
+type StateFlow struct {
+	ID             string            `json:"id" yaml:"id"`
+	Name           string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Source         string            `json:"source,omitempty" yaml:"source,omitempty"`
+	Configurations map[string]string `json:"configurations,omitempty" yaml:"configurations,omitempty"`
+	Deploy         bool              `json:"deploy,omitempty" yaml:"deploy,omitempty"`
+}
+
+//StateAction - change of the tenant computed by plan
+type StateAction struct {
+	Resource string `header:"Resource"`
+	ID       string `header:"Id"`
+	Action   string `header:"Action"`
+	Detail   string `header:"Detail"`
+}
+
+//StatePlan - changes in the order of execution
+type StatePlan struct {
+	Actions []StateAction
+}
+
+func (p *StatePlan) Print(out io.Writer) {
+	if len(p.Actions) == 0 {
+		io.WriteString(out, "No changes, the tenant matches the state file\n")
+		return
+	}
+	tableprinter.Print(out, p.Actions)
+}
+
+//StateActionResult - result of the executed action
+type StateActionResult struct {
+	Resource string `header:"Resource"`
+	ID       string `header:"Id"`
+	Action   string `header:"Action"`
+	Result   string `header:"Result"`
+}
+
+//StateReport - final report of apply
+type StateReport struct {
+	Results []StateActionResult
+}
+
+func (r *StateReport) Print(out io.Writer) {
+	if len(r.Results) == 0 {
+		io.WriteString(out, "No changes, the tenant matches the state file\n")
+		return
+	}
+	tableprinter.Print(out, r.Results)
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"encoding/xml"
+)
+
+//ValueMappingDocument is the content of value_mapping.xml file from the value mapping artifact
+type ValueMappingDocument struct {
+	XMLName xml.Name            `xml:"vm"`
+	Version string              `xml:"version,attr"`
+	Groups  []ValueMappingGroup `xml:"group"`
+}
+
+type ValueMappingGroup struct {
+	ID      string              `xml:"id,attr"`
+	Entries []ValueMappingEntry `xml:"entry"`
+}
+
+type ValueMappingEntry struct {
+	Agency string `xml:"agency"`
+	Schema string `xml:"schema"`
+	Value  string `xml:"value"`
+}
+
+//ValueMappingRow is one line of the value mapping CSV file
+type ValueMappingRow struct {
+	GroupID      string
+	SourceAgency string
+	SourceSchema string
+	SourceValue  string
+	TargetAgency string
+	TargetSchema string
+	TargetValue  string
+}
+
+//ValueMappingCSVHeader - columns of the value mapping CSV file
+var ValueMappingCSVHeader = []string{"GroupId", "SourceAgency", "SourceSchema", "SourceValue", "TargetAgency", "TargetSchema", "TargetValue"}
+
+func (r ValueMappingRow) Record() []string {
+	return []string{r.GroupID, r.SourceAgency, r.SourceSchema, r.SourceValue, r.TargetAgency, r.TargetSchema, r.TargetValue}
+}
+
+//Key identifies the mapping independently of the group id and the target value
+func (r ValueMappingRow) Key() string {
+	return r.SourceAgency + "|" + r.SourceSchema + "|" + r.SourceValue + "|" + r.TargetAgency + "|" + r.TargetSchema
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"io"
+
+	"github.com/lensesio/tableprinter"
+)
+
+//WorkspacePackage is the file format of package.json of the package directory in the workspace
+type WorkspacePackage struct {
+	Package   IntegrationPackage  `json:"Package"`
+	Artifacts []WorkspaceArtifact `json:"Artifacts"`
+}
+
+//WorkspaceArtifact - designtime artifact of the package, the content is extracted into the directory named by Id
+type WorkspaceArtifact struct {
+	Type    string `json:"Type"`
+	ID      string `json:"Id"`
+	Version string `json:"Version"`
+	Name    string `json:"Name"`
+}
+
+//Get returns the artifact of the type with the id or nil
+func (p *WorkspacePackage) Get(artifactType string, id string) *WorkspaceArtifact {
+	for i, a := range p.Artifacts {
+		if a.Type == artifactType && a.ID == id {
+			return &p.Artifacts[i]
+		}
+	}
+	return nil
+}
+
+//WorkspaceChange - difference between the workspace directory and the tenant
+type WorkspaceChange struct {
+	Status    string `header:"Status"`
+	PackageID string `header:"PackageId"`
+	Type      string `header:"Type"`
+	ID        string `header:"Id"`
+	Detail    string `header:"Detail"`
+}
+
+//WorkspaceStatus - list of the changed artifacts
+type WorkspaceStatus struct {
+	Changes []WorkspaceChange
+}
+
+func (s *WorkspaceStatus) Print(out io.Writer) {
+	if len(s.Changes) == 0 {
+		io.WriteString(out, "Workspace is up to date\n")
+		return
+	}
+	tableprinter.Print(out, s.Changes)
+}
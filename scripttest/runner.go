@@ -0,0 +1,118 @@
+package scripttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+//Runner - runs the scripts with groovy, the working directory keeps the stub of the Message API and the scripts
+type Runner struct {
+	groovy string
+	dir    string
+}
+
+//Result - result of the fixture, Err is set when the script failed
+type Result struct {
+	Fixture     Fixture
+	Output      *Message
+	Differences []string
+	Err         error
+}
+
+//Passed reports whether the script ran without an error and the output matches the expected message
+func (r Result) Passed() bool {
+	return r.Err == nil && len(r.Differences) == 0
+}
+
+//NewRunner creates the working directory with the stub of the Message API, groovy is the command or the path of groovy
+func NewRunner(groovy string) (*Runner, error) {
+	path, err := exec.LookPath(groovy)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrGroovy, err)
+	}
+	dir, err := os.MkdirTemp("", "cig-script-test")
+	if err != nil {
+		return nil, err
+	}
+	r := &Runner{groovy: path, dir: dir}
+	if err := WriteStub(dir); err != nil {
+		r.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+//WriteStub writes the stub of the Message API and the runner script into the directory
+func WriteStub(dir string) error {
+	for name, content := range stubFiles {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(content), 0666); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Close removes the working directory
+func (r *Runner) Close() error {
+	return os.RemoveAll(r.dir)
+}
+
+//Run calls the function of the script with the input message and returns the message after the call
+func (r *Runner) Run(scriptName string, script []byte, function string, input Message) (*Message, error) {
+	work, err := os.MkdirTemp(r.dir, "run")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(work)
+
+	scriptFile := filepath.Join(work, filepath.Base(scriptName))
+	inputFile := filepath.Join(work, "input.json")
+	outputFile := filepath.Join(work, "output.json")
+	if err := os.WriteFile(scriptFile, script, 0666); err != nil {
+		return nil, err
+	}
+	input.BodyFile = ""
+	b, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(inputFile, b, 0666); err != nil {
+		return nil, err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(r.groovy, "-cp", r.dir, filepath.Join(r.dir, runnerFile), scriptFile, function, inputFile, outputFile)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%w: %s: %s\n%s", ErrGroovy, scriptName, err, strings.TrimSpace(stderr.String()))
+	}
+
+	b, err = os.ReadFile(outputFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: output not written: %s", ErrGroovy, scriptName, err)
+	}
+	var output Message
+	if err := json.Unmarshal(b, &output); err != nil {
+		return nil, fmt.Errorf("%w: %s: %s", ErrGroovy, scriptName, err)
+	}
+	return &output, nil
+}
+
+//Test runs the fixture with the script and compares the output to the expected message
+func (r *Runner) Test(fixture Fixture, script []byte) Result {
+	result := Result{Fixture: fixture}
+	result.Output, result.Err = r.Run(fixture.Script, script, fixture.Function, fixture.Input)
+	if result.Err == nil {
+		result.Differences = Compare(fixture.Expected, *result.Output)
+	}
+	return result
+}
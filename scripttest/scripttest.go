@@ -0,0 +1,134 @@
+package scripttest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	//ErrInvalid - invalid fixture
+	ErrInvalid = errors.New("invalid fixture")
+	//ErrGroovy - groovy not found or the script failed
+	ErrGroovy = errors.New("groovy error")
+)
+
+//DefaultFunction - function of the script called when the fixture does not set it
+const DefaultFunction = "processData"
+
+//Message - body, headers and properties of the message, BodyFile is the file with the body relative to the fixture
+type Message struct {
+	Body       *string           `json:"body,omitempty"`
+	BodyFile   string            `json:"bodyFile,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+//Fixture - test of the script, the function is called with the input message and the result is compared
+//to the expected message. Only the body, headers and properties set in the expected message are compared.
+type Fixture struct {
+	Name     string  `json:"-"`
+	Script   string  `json:"script"`
+	Function string  `json:"function,omitempty"`
+	Input    Message `json:"input"`
+	Expected Message `json:"expected"`
+}
+
+//ReadFixtures reads the json files of the directory in the order of the names
+func ReadFixtures(dir string) ([]Fixture, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	var fixtures []Fixture
+	for _, name := range names {
+		f, err := ReadFixture(name)
+		if err != nil {
+			return nil, err
+		}
+		fixtures = append(fixtures, *f)
+	}
+	if len(fixtures) == 0 {
+		return nil, fmt.Errorf("%w: no json files in %s", ErrInvalid, dir)
+	}
+	return fixtures, nil
+}
+
+//ReadFixture reads the fixture file, the body files are read relative to the fixture
+func ReadFixture(fileName string) (*Fixture, error) {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var f Fixture
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("%w: %s: %s", ErrInvalid, fileName, err)
+	}
+	if f.Script == "" {
+		return nil, fmt.Errorf("%w: %s: script not set", ErrInvalid, fileName)
+	}
+	if f.Function == "" {
+		f.Function = DefaultFunction
+	}
+	f.Name = strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+
+	for _, m := range []*Message{&f.Input, &f.Expected} {
+		if m.BodyFile == "" {
+			continue
+		}
+		body, err := os.ReadFile(filepath.Join(filepath.Dir(fileName), filepath.FromSlash(m.BodyFile)))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %s", ErrInvalid, fileName, err)
+		}
+		s := string(body)
+		m.Body = &s
+	}
+	return &f, nil
+}
+
+//Compare returns the differences of the actual message to the expected message, line endings of the body are ignored
+func Compare(expected Message, actual Message) []string {
+	var diffs []string
+	if expected.Body != nil {
+		exp := normalizeBody(*expected.Body)
+		got := ""
+		if actual.Body != nil {
+			got = normalizeBody(*actual.Body)
+		}
+		if exp != got {
+			diffs = append(diffs, fmt.Sprintf("body: expected %q, got %q", exp, got))
+		}
+	}
+	diffs = append(diffs, compareValues("header", expected.Headers, actual.Headers)...)
+	diffs = append(diffs, compareValues("property", expected.Properties, actual.Properties)...)
+	return diffs
+}
+
+func normalizeBody(body string) string {
+	return strings.TrimRight(strings.ReplaceAll(body, "\r\n", "\n"), "\n")
+}
+
+func compareValues(kind string, expected map[string]string, actual map[string]string) []string {
+	keys := make([]string, 0, len(expected))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var diffs []string
+	for _, k := range keys {
+		got, ok := actual[k]
+		switch {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("%s %s: expected %q, not set", kind, k, expected[k]))
+		case got != expected[k]:
+			diffs = append(diffs, fmt.Sprintf("%s %s: expected %q, got %q", kind, k, expected[k], got))
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,176 @@
+package scripttest_test
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/tobiaszgithub/cig/scripttest"
+)
+
+func str(s string) *string {
+	return &s
+}
+
+//fakeGroovy writes the shell script called instead of groovy, the script checks the stub and writes the input message as output
+func fakeGroovy(t *testing.T, body string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake groovy is a shell script")
+	}
+	path := filepath.Join(t.TempDir(), "groovy")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0777); err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	return path
+}
+
+func TestReadFixture(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "order.xml"), []byte("<order/>\n"), 0666)
+	os.WriteFile(filepath.Join(dir, "b.json"), []byte(`{"script": "script/b.groovy", "function": "run", "input": {}, "expected": {"body": "ok"}}`), 0666)
+	os.WriteFile(filepath.Join(dir, "a.json"), []byte(`{
+		"script": "a.groovy",
+		"input": {"bodyFile": "order.xml", "headers": {"SAP_Sender": "ERP"}, "properties": {"mode": "test"}},
+		"expected": {"headers": {"OrderType": "standard"}}
+	}`), 0666)
+
+	fixtures, err := scripttest.ReadFixtures(dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	exp := []scripttest.Fixture{
+		{Name: "a", Script: "a.groovy", Function: scripttest.DefaultFunction,
+			Input: scripttest.Message{Body: str("<order/>\n"), BodyFile: "order.xml",
+				Headers: map[string]string{"SAP_Sender": "ERP"}, Properties: map[string]string{"mode": "test"}},
+			Expected: scripttest.Message{Headers: map[string]string{"OrderType": "standard"}}},
+		{Name: "b", Script: "script/b.groovy", Function: "run", Expected: scripttest.Message{Body: str("ok")}},
+	}
+	if !reflect.DeepEqual(fixtures, exp) {
+		t.Errorf("Expected fixtures:\n%+v\ngot:\n%+v", exp, fixtures)
+	}
+
+	os.WriteFile(filepath.Join(dir, "c.json"), []byte(`{"input": {}}`), 0666)
+	if _, err := scripttest.ReadFixtures(dir); !errors.Is(err, scripttest.ErrInvalid) {
+		t.Errorf("Expected error %q, got %q.", scripttest.ErrInvalid, err)
+	}
+	if _, err := scripttest.ReadFixtures(t.TempDir()); !errors.Is(err, scripttest.ErrInvalid) {
+		t.Errorf("Expected error %q, got %q.", scripttest.ErrInvalid, err)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	actual := scripttest.Message{
+		Body:       str("<order>\r\n</order>\r\n"),
+		Headers:    map[string]string{"OrderType": "express", "SAP_Sender": "ERP"},
+		Properties: map[string]string{"mode": "test"},
+	}
+	testCases := []struct {
+		name     string
+		expected scripttest.Message
+		exp      []string
+	}{
+		{name: "equal", expected: scripttest.Message{Body: str("<order>\n</order>"), Headers: map[string]string{"SAP_Sender": "ERP"}}},
+		{name: "notCompared", expected: scripttest.Message{}},
+		{
+			name: "different",
+			expected: scripttest.Message{
+				Body:       str("<order/>"),
+				Headers:    map[string]string{"OrderType": "standard"},
+				Properties: map[string]string{"mode": "test", "status": "done"},
+			},
+			exp: []string{
+				`body: expected "<order/>", got "<order>\n</order>"`,
+				`header OrderType: expected "standard", got "express"`,
+				`property status: expected "done", not set`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diffs := scripttest.Compare(tc.expected, actual); !reflect.DeepEqual(diffs, tc.exp) {
+				t.Errorf("Expected differences %q, got %q", tc.exp, diffs)
+			}
+		})
+	}
+}
+
+func TestRunner(t *testing.T) {
+	groovy := fakeGroovy(t, `test -f "$2/com/sap/gateway/ip/core/customdev/util/Message.groovy" || exit 2
+test -f "$3" && test -f "$4" && test "$5" = processData || exit 3
+cp "$6" "$7"`)
+
+	runner, err := scripttest.NewRunner(groovy)
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	defer runner.Close()
+
+	fixture := scripttest.Fixture{
+		Name: "echo", Script: "script/echo.groovy", Function: scripttest.DefaultFunction,
+		Input:    scripttest.Message{Body: str("<order/>"), Headers: map[string]string{"SAP_Sender": "ERP"}},
+		Expected: scripttest.Message{Body: str("<order/>"), Headers: map[string]string{"SAP_Sender": "ERP", "OrderType": "standard"}},
+	}
+	result := runner.Test(fixture, []byte("def Message processData(Message message) { return message }"))
+	if result.Err != nil {
+		t.Fatalf("Expected no error, got %q.", result.Err)
+	}
+	if result.Passed() || !reflect.DeepEqual(result.Differences, []string{`header OrderType: expected "standard", not set`}) {
+		t.Errorf("Unexpected result %+v", result)
+	}
+
+	failing, err := scripttest.NewRunner(fakeGroovy(t, `echo "No signature of method: processData" >&2; exit 1`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	defer failing.Close()
+	if result := failing.Test(fixture, nil); !errors.Is(result.Err, scripttest.ErrGroovy) || result.Passed() {
+		t.Errorf("Expected error %q, got %q.", scripttest.ErrGroovy, result.Err)
+	}
+
+	if _, err := scripttest.NewRunner(filepath.Join(t.TempDir(), "no-groovy")); !errors.Is(err, scripttest.ErrGroovy) {
+		t.Errorf("Expected error %q, got %q.", scripttest.ErrGroovy, err)
+	}
+}
+
+func TestRunnerGroovy(t *testing.T) {
+	if _, err := exec.LookPath("groovy"); err != nil {
+		t.Skip("groovy not found")
+	}
+
+	runner, err := scripttest.NewRunner("groovy")
+	if err != nil {
+		t.Fatalf("Expected no error, got %q.", err)
+	}
+	defer runner.Close()
+
+	script := []byte(`import com.sap.gateway.ip.core.customdev.util.Message
+
+def Message processData(Message message) {
+	def body = message.getBody(String)
+	message.setHeader("OrderType", body.contains("express") ? "express" : "standard")
+	message.setProperty("mode", "processed")
+	messageLogFactory.getMessageLog(message)?.setStringProperty("OrderType", message.getHeaders().get("OrderType"))
+	message.body = body.toUpperCase()
+	return message
+}
+`)
+	fixture := scripttest.Fixture{
+		Name: "order", Script: "script/order.groovy", Function: scripttest.DefaultFunction,
+		Input: scripttest.Message{Body: str(`<order type="express"/>`), Properties: map[string]string{"mode": "test"}},
+		Expected: scripttest.Message{Body: str(`<ORDER TYPE="EXPRESS"/>`), Headers: map[string]string{"OrderType": "express"},
+			Properties: map[string]string{"mode": "processed"}},
+	}
+	result := runner.Test(fixture, script)
+	if !result.Passed() {
+		t.Fatalf("Unexpected result %+v", result)
+	}
+	if _, ok := result.Output.Properties["body"]; ok {
+		t.Errorf("Assignment of message.body should not set the property body: %+v", result.Output.Properties)
+	}
+}
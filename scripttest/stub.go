@@ -0,0 +1,176 @@
+package scripttest
+
+//stubFiles - sources of the stub of the Message API and of the runner, the paths are relative to the classpath
+var stubFiles = map[string]string{
+	"com/sap/gateway/ip/core/customdev/util/Message.groovy": messageStub,
+	"com/sap/it/api/msglog/MessageLog.groovy":               messageLogStub,
+	"com/sap/it/api/msglog/MessageLogFactory.groovy":        messageLogFactoryStub,
+	runnerFile: runnerScript,
+}
+
+const runnerFile = "runner.groovy"
+
+//messageStub - stub of com.sap.gateway.ip.core.customdev.util.Message, the body is converted on getBody like in the runtime.
+//getProperty and setProperty of the API override the dynamic properties of groovy, so message.body and message.body = x
+//work only when there is no exchange property body.
+const messageStub = `package com.sap.gateway.ip.core.customdev.util
+
+class Message {
+	private Object body
+	private Map<String, Object> headers = [:]
+	private Map<String, Object> properties = [:]
+	private Map<String, Object> attachments = [:]
+
+	Object getBody() {
+		return body
+	}
+
+	def <T> T getBody(Class<T> type) {
+		if (body == null || type.isInstance(body)) {
+			return (T) body
+		}
+		byte[] bytes = bodyBytes()
+		if (type == String) {
+			return (T) new String(bytes, "UTF-8")
+		}
+		if (type == byte[]) {
+			return (T) bytes
+		}
+		if (type == InputStream) {
+			return (T) new ByteArrayInputStream(bytes)
+		}
+		if (type == Reader) {
+			return (T) new InputStreamReader(new ByteArrayInputStream(bytes), "UTF-8")
+		}
+		return body.asType(type)
+	}
+
+	void setBody(Object body) {
+		this.body = body
+	}
+
+	byte[] bodyBytes() {
+		if (body instanceof byte[]) {
+			return (byte[]) body
+		}
+		if (body instanceof InputStream) {
+			body = ((InputStream) body).bytes
+			return (byte[]) body
+		}
+		if (body instanceof Reader) {
+			body = ((Reader) body).text
+		}
+		return body.toString().getBytes("UTF-8")
+	}
+
+	Map<String, Object> getHeaders() {
+		return headers
+	}
+
+	def <T> T getHeader(String name, Class<T> type) {
+		def value = headers.get(name)
+		return value == null ? null : value.asType(type)
+	}
+
+	void setHeader(String name, Object value) {
+		headers.put(name, value)
+	}
+
+	void setHeaders(Map<String, Object> headers) {
+		this.headers = new LinkedHashMap<>(headers)
+	}
+
+	Map<String, Object> getProperties() {
+		return properties
+	}
+
+	Object getProperty(String name) {
+		if (!properties.containsKey(name) && getMetaClass().hasProperty(this, name)) {
+			return getMetaClass().getProperty(this, name)
+		}
+		return properties.get(name)
+	}
+
+	void setProperty(String name, Object value) {
+		if (!properties.containsKey(name) && getMetaClass().hasProperty(this, name)) {
+			getMetaClass().setProperty(this, name, value)
+			return
+		}
+		properties.put(name, value)
+	}
+
+	void setProperties(Map<String, Object> properties) {
+		this.properties = new LinkedHashMap<>(properties)
+	}
+
+	Map<String, Object> getAttachmentObjects() {
+		return attachments
+	}
+
+	void addAttachmentObject(String id, Object content) {
+		attachments.put(id, content)
+	}
+}
+`
+
+//messageLogStub - stub of com.sap.it.api.msglog.MessageLog, the log is written to stderr
+const messageLogStub = `package com.sap.it.api.msglog
+
+class MessageLog {
+	Map<String, String> properties = [:]
+
+	void setStringProperty(String name, String value) {
+		properties[name] = value
+		System.err.println("property " + name + ": " + value)
+	}
+
+	void addAttachmentAsString(String name, String text, String mediaType) {
+		System.err.println("attachment " + name + " (" + mediaType + "): " + text?.length() + " characters")
+	}
+
+	void addCustomHeaderProperty(String name, String value) {
+		System.err.println("custom header " + name + ": " + value)
+	}
+}
+`
+
+const messageLogFactoryStub = `package com.sap.it.api.msglog
+
+class MessageLogFactory {
+	MessageLog getMessageLog(Object message) {
+		return new MessageLog()
+	}
+}
+`
+
+//runnerScript - runs the function of the script with the message of the input file and writes the message to the output file,
+//arguments: script function input output
+const runnerScript = `import com.sap.gateway.ip.core.customdev.util.Message
+import com.sap.it.api.msglog.MessageLogFactory
+import groovy.json.JsonOutput
+import groovy.json.JsonSlurper
+
+def (scriptFile, function, inputFile, outputFile) = args
+
+def input = new JsonSlurper().parse(new File(inputFile), "UTF-8")
+def message = new Message()
+message.setBody(input.body)
+message.setHeaders(input.headers ?: [:])
+message.setProperties(input.properties ?: [:])
+
+def binding = new Binding()
+binding.setVariable("messageLogFactory", new MessageLogFactory())
+def script = new GroovyShell(getClass().getClassLoader(), binding).parse(new File(scriptFile))
+def result = script.invokeMethod(function, message)
+if (result instanceof Message) {
+	message = result
+}
+
+def text = { value -> value == null ? null : (value instanceof byte[] ? new String(value, "UTF-8") : value.toString()) }
+def output = [
+	body      : message.getBody() == null ? null : new String(message.bodyBytes(), "UTF-8"),
+	headers   : message.getHeaders().collectEntries { k, v -> [k, text(v)] },
+	properties: message.getProperties().collectEntries { k, v -> [k, text(v)] },
+]
+new File(outputFile).write(JsonOutput.toJson(output), "UTF-8")
+`